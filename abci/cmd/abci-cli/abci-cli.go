@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -44,6 +45,12 @@ var (
 	flagHeight int
 	flagProve  bool
 
+	// check_tx
+	flagRecheck bool
+
+	// prepare_proposal
+	flagMaxTxBytes int64
+
 	// counter
 	flagSerial bool
 
@@ -95,6 +102,9 @@ type response struct {
 	Log  string
 
 	Query *queryResponse
+
+	// txs returned by prepare_proposal
+	Txs [][]byte
 }
 
 type queryResponse struct {
@@ -123,6 +133,14 @@ func addQueryFlags() {
 	queryCmd.PersistentFlags().BoolVarP(&flagProve, "prove", "", false, "whether or not to return a merkle proof of the query result")
 }
 
+func addCheckTxFlags() {
+	checkTxCmd.PersistentFlags().BoolVarP(&flagRecheck, "recheck", "", false, "check the tx as a recheck of a tx already in the mempool")
+}
+
+func addPrepareProposalFlags() {
+	prepareProposalCmd.PersistentFlags().Int64VarP(&flagMaxTxBytes, "max_tx_bytes", "", 1048576, "max total size of the txs of the proposal")
+}
+
 func addCounterFlags() {
 	counterCmd.PersistentFlags().BoolVarP(&flagSerial, "serial", "", false, "enforce incrementing (serial) transactions")
 }
@@ -138,8 +156,14 @@ func addCommands() {
 	RootCmd.AddCommand(infoCmd)
 	RootCmd.AddCommand(setOptionCmd)
 	RootCmd.AddCommand(deliverTxCmd)
+	addCheckTxFlags()
 	RootCmd.AddCommand(checkTxCmd)
 	RootCmd.AddCommand(commitCmd)
+	addPrepareProposalFlags()
+	RootCmd.AddCommand(prepareProposalCmd)
+	RootCmd.AddCommand(processProposalCmd)
+	RootCmd.AddCommand(extendVoteCmd)
+	RootCmd.AddCommand(verifyVoteExtensionCmd)
 	RootCmd.AddCommand(versionCmd)
 	RootCmd.AddCommand(testCmd)
 	addQueryFlags()
@@ -188,7 +212,7 @@ This command opens an interactive console for running any of the other commands
 without opening a new connection each time
 `,
 	Args:      cobra.ExactArgs(0),
-	ValidArgs: []string{"echo", "info", "set_option", "deliver_tx", "check_tx", "commit", "query"},
+	ValidArgs: []string{"echo", "info", "set_option", "deliver_tx", "check_tx", "commit", "prepare_proposal", "process_proposal", "extend_vote", "verify_vote_extension", "query"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmdConsole(cmd, args)
 	},
@@ -252,6 +276,46 @@ var commitCmd = &cobra.Command{
 	},
 }
 
+var prepareProposalCmd = &cobra.Command{
+	Use:   "prepare_proposal",
+	Short: "have the application build the txs of a proposal from the given txs",
+	Long:  "have the application build the txs of a proposal from the given txs",
+	Args:  cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmdPrepareProposal(cmd, args)
+	},
+}
+
+var processProposalCmd = &cobra.Command{
+	Use:   "process_proposal",
+	Short: "ask the application whether to accept a proposal with the given txs",
+	Long:  "ask the application whether to accept a proposal with the given txs",
+	Args:  cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmdProcessProposal(cmd, args)
+	},
+}
+
+var extendVoteCmd = &cobra.Command{
+	Use:   "extend_vote",
+	Short: "get the extension of a precommit at the given height",
+	Long:  "get the extension of a precommit at the given height",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmdExtendVote(cmd, args)
+	},
+}
+
+var verifyVoteExtensionCmd = &cobra.Command{
+	Use:   "verify_vote_extension",
+	Short: "ask the application whether to accept the extension of a precommit at the given height",
+	Long:  "ask the application whether to accept the extension of a precommit at the given height",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmdVerifyVoteExtension(cmd, args)
+	},
+}
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "print ABCI console version",
@@ -446,12 +510,20 @@ func muxOnCommands(cmd *cobra.Command, pArgs []string) error {
 		return cmdDeliverTx(cmd, actualArgs)
 	case "echo":
 		return cmdEcho(cmd, actualArgs)
+	case "extend_vote":
+		return cmdExtendVote(cmd, actualArgs)
 	case "info":
 		return cmdInfo(cmd, actualArgs)
+	case "prepare_proposal":
+		return cmdPrepareProposal(cmd, actualArgs)
+	case "process_proposal":
+		return cmdProcessProposal(cmd, actualArgs)
 	case "query":
 		return cmdQuery(cmd, actualArgs)
 	case "set_option":
 		return cmdSetOption(cmd, actualArgs)
+	case "verify_vote_extension":
+		return cmdVerifyVoteExtension(cmd, actualArgs)
 	default:
 		return cmdUnimplemented(cmd, pArgs)
 	}
@@ -475,6 +547,10 @@ func cmdUnimplemented(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s: %s\n", deliverTxCmd.Use, deliverTxCmd.Short)
 	fmt.Printf("%s: %s\n", queryCmd.Use, queryCmd.Short)
 	fmt.Printf("%s: %s\n", commitCmd.Use, commitCmd.Short)
+	fmt.Printf("%s: %s\n", prepareProposalCmd.Use, prepareProposalCmd.Short)
+	fmt.Printf("%s: %s\n", processProposalCmd.Use, processProposalCmd.Short)
+	fmt.Printf("%s: %s\n", extendVoteCmd.Use, extendVoteCmd.Short)
+	fmt.Printf("%s: %s\n", verifyVoteExtensionCmd.Use, verifyVoteExtensionCmd.Short)
 	fmt.Printf("%s: %s\n", setOptionCmd.Use, setOptionCmd.Short)
 	fmt.Println("Use \"[command] --help\" for more information about a command.")
 
@@ -573,7 +649,11 @@ func cmdCheckTx(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	res, err := client.CheckTxSync(txBytes)
+	req := types.RequestCheckTx{Tx: txBytes}
+	if flagRecheck {
+		req.Type = types.CheckTxType_Recheck
+	}
+	res, err := client.CheckTxSync(req)
 	if err != nil {
 		return err
 	}
@@ -598,6 +678,106 @@ func cmdCommit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Build the txs of a proposal
+func cmdPrepareProposal(cmd *cobra.Command, args []string) error {
+	txs := make([][]byte, len(args))
+	for i, arg := range args {
+		txBytes, err := stringOrHexToBytes(arg)
+		if err != nil {
+			return err
+		}
+		txs[i] = txBytes
+	}
+	res, err := client.PrepareProposalSync(types.RequestPrepareProposal{
+		Txs:        txs,
+		MaxTxBytes: flagMaxTxBytes,
+	})
+	if err != nil {
+		return err
+	}
+	printResponse(cmd, args, response{
+		Txs: res.Txs,
+	})
+	return nil
+}
+
+// Accept or reject a proposal
+func cmdProcessProposal(cmd *cobra.Command, args []string) error {
+	txs := make([][]byte, len(args))
+	for i, arg := range args {
+		txBytes, err := stringOrHexToBytes(arg)
+		if err != nil {
+			return err
+		}
+		txs[i] = txBytes
+	}
+	res, err := client.ProcessProposalSync(types.RequestProcessProposal{Txs: txs})
+	if err != nil {
+		return err
+	}
+	if !res.Accept {
+		printResponse(cmd, args, response{Code: codeBad, Log: "proposal rejected"})
+		return nil
+	}
+	printResponse(cmd, args, response{Log: "proposal accepted"})
+	return nil
+}
+
+// Extend a precommit
+func cmdExtendVote(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		printResponse(cmd, args, response{
+			Code: codeBad,
+			Log:  "want the height",
+		})
+		return nil
+	}
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	res, err := client.ExtendVoteSync(types.RequestExtendVote{Height: height})
+	if err != nil {
+		return err
+	}
+	printResponse(cmd, args, response{
+		Data: res.VoteExtension,
+	})
+	return nil
+}
+
+// Accept or reject the extension of a precommit
+func cmdVerifyVoteExtension(cmd *cobra.Command, args []string) error {
+	if len(args) < 2 {
+		printResponse(cmd, args, response{
+			Code: codeBad,
+			Log:  "want the height and the extension",
+		})
+		return nil
+	}
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	ext, err := stringOrHexToBytes(args[1])
+	if err != nil {
+		return err
+	}
+	res, err := client.VerifyVoteExtensionSync(types.RequestVerifyVoteExtension{
+		Height:        height,
+		VoteExtension: ext,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Accept {
+		printResponse(cmd, args, response{Code: codeBad, Log: "vote extension rejected"})
+		return nil
+	}
+	printResponse(cmd, args, response{Log: "vote extension accepted"})
+	return nil
+}
+
 // Query application state
 func cmdQuery(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
@@ -718,6 +898,11 @@ func printResponse(cmd *cobra.Command, args []string, rsp response) {
 		fmt.Printf("-> log: %s\n", rsp.Log)
 	}
 
+	for _, tx := range rsp.Txs {
+		fmt.Printf("-> tx: %s\n", tx)
+		fmt.Printf("-> tx.hex: 0x%X\n", tx)
+	}
+
 	if rsp.Query != nil {
 		fmt.Printf("-> height: %d\n", rsp.Query.Height)
 		if rsp.Query.Key != nil {
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/tendermint/abci/example/code"
 	"github.com/tendermint/tendermint/abci/types"
@@ -94,10 +95,53 @@ func (app *KVStoreApplication) DeliverTx(tx []byte) types.ResponseDeliverTx {
 	return types.ResponseDeliverTx{Code: code.CodeTypeOK, Tags: tags}
 }
 
-func (app *KVStoreApplication) CheckTx(tx []byte) types.ResponseCheckTx {
+func (app *KVStoreApplication) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 	return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
 }
 
+// PrepareProposal drops duplicate txs, since delivering the same tx twice
+// doesn't change the store
+func (app *KVStoreApplication) PrepareProposal(req types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	txs := make([][]byte, 0, len(req.Txs))
+	seen := make(map[string]struct{}, len(req.Txs))
+	for _, tx := range req.Txs {
+		if _, ok := seen[string(tx)]; ok {
+			continue
+		}
+		seen[string(tx)] = struct{}{}
+		txs = append(txs, tx)
+	}
+	return types.ResponsePrepareProposal{Txs: txs}
+}
+
+// ProcessProposal rejects blocks with duplicate txs, which PrepareProposal
+// would have dropped
+func (app *KVStoreApplication) ProcessProposal(req types.RequestProcessProposal) types.ResponseProcessProposal {
+	seen := make(map[string]struct{}, len(req.Txs))
+	for _, tx := range req.Txs {
+		if _, ok := seen[string(tx)]; ok {
+			return types.ResponseProcessProposal{Accept: false}
+		}
+		seen[string(tx)] = struct{}{}
+	}
+	return types.ResponseProcessProposal{Accept: true}
+}
+
+// ExtendVote extends precommits with the height they're for, so that the
+// extensions of other validators can be checked by VerifyVoteExtension
+func (app *KVStoreApplication) ExtendVote(req types.RequestExtendVote) types.ResponseExtendVote {
+	return types.ResponseExtendVote{VoteExtension: voteExtension(req.Height)}
+}
+
+// VerifyVoteExtension only accepts precommits extended with their height
+func (app *KVStoreApplication) VerifyVoteExtension(req types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	return types.ResponseVerifyVoteExtension{Accept: bytes.Equal(req.VoteExtension, voteExtension(req.Height))}
+}
+
+func voteExtension(height int64) []byte {
+	return []byte(strconv.FormatInt(height, 10))
+}
+
 func (app *KVStoreApplication) Commit() types.ResponseCommit {
 	// Using a memdb - just return the big endian size of the db
 	appHash := make([]byte, 8)
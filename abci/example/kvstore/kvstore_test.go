@@ -55,6 +55,31 @@ func TestKVStoreKV(t *testing.T) {
 	testKVStore(t, kvstore, tx, key, value)
 }
 
+func TestKVStorePrepareProposal(t *testing.T) {
+	kvstore := NewKVStoreApplication()
+	txs := [][]byte{[]byte("a=1"), []byte("b=2"), []byte("a=1"), []byte("c=3")}
+	res := kvstore.PrepareProposal(types.RequestPrepareProposal{Txs: txs, MaxTxBytes: 100, Height: 1})
+	require.Equal(t, [][]byte{[]byte("a=1"), []byte("b=2"), []byte("c=3")}, res.Txs)
+}
+
+func TestKVStoreProcessProposal(t *testing.T) {
+	kvstore := NewKVStoreApplication()
+	res := kvstore.ProcessProposal(types.RequestProcessProposal{Txs: [][]byte{[]byte("a=1"), []byte("b=2")}})
+	require.True(t, res.Accept)
+	res = kvstore.ProcessProposal(types.RequestProcessProposal{Txs: [][]byte{[]byte("a=1"), []byte("a=1")}})
+	require.False(t, res.Accept)
+}
+
+func TestKVStoreVoteExtension(t *testing.T) {
+	kvstore := NewKVStoreApplication()
+	ext := kvstore.ExtendVote(types.RequestExtendVote{Hash: []byte("hash"), Height: 5}).VoteExtension
+	require.Equal(t, []byte("5"), ext)
+	res := kvstore.VerifyVoteExtension(types.RequestVerifyVoteExtension{Hash: []byte("hash"), Height: 5, VoteExtension: ext})
+	require.True(t, res.Accept)
+	res = kvstore.VerifyVoteExtension(types.RequestVerifyVoteExtension{Hash: []byte("hash"), Height: 6, VoteExtension: ext})
+	require.False(t, res.Accept)
+}
+
 func TestPersistentKVStoreKV(t *testing.T) {
 	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
 	if err != nil {
@@ -308,4 +333,28 @@ func testClient(t *testing.T, app abcicli.Client, tx []byte, key, value string)
 	require.Nil(t, err)
 	require.Equal(t, code.CodeTypeOK, resQuery.Code)
 	require.Equal(t, value, string(resQuery.Value))
+
+	// duplicate txs are dropped from the proposal
+	resPrepare, err := app.PrepareProposalSync(types.RequestPrepareProposal{
+		Txs:        [][]byte{tx, tx},
+		MaxTxBytes: 100,
+	})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{tx}, resPrepare.Txs)
+
+	// and proposals with duplicate txs are rejected
+	resProcess, err := app.ProcessProposalSync(types.RequestProcessProposal{Txs: [][]byte{tx, tx}})
+	require.Nil(t, err)
+	require.False(t, resProcess.Accept)
+
+	// precommits are extended with their height
+	resExtend, err := app.ExtendVoteSync(types.RequestExtendVote{Hash: []byte("hash"), Height: 1})
+	require.Nil(t, err)
+	resVerify, err := app.VerifyVoteExtensionSync(types.RequestVerifyVoteExtension{
+		Hash:          []byte("hash"),
+		Height:        1,
+		VoteExtension: resExtend.VoteExtension,
+	})
+	require.Nil(t, err)
+	require.True(t, resVerify.Accept)
 }
@@ -56,7 +56,7 @@ func deliverTx(client abcicli.Client, txBytes []byte, codeExp uint32, dataExp []
 }
 
 /*func checkTx(client abcicli.Client, txBytes []byte, codeExp uint32, dataExp []byte) {
-	res, err := client.CheckTxSync(txBytes)
+	res, err := client.CheckTxSync(types.RequestCheckTx{Tx: txBytes})
 	if err != nil {
 		panicf("client error: %v", err)
 	}
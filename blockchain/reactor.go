@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -229,6 +230,14 @@ func (bcR *BlockchainReactor) poolRoutine() {
 
 	didProcessCh := make(chan struct{}, 1)
 
+	verifier := newCommitVerifier(chainID, 0)
+	defer verifier.stop()
+
+	// We may have restarted after halting.
+	if bcR.haltIfNeeded(state) {
+		return
+	}
+
 FOR_LOOP:
 	for {
 		select {
@@ -300,15 +309,12 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts := first.MakePartSet(types.BlockPartSizeBytes)
-			firstPartsHeader := firstParts.Header()
-			firstID := types.BlockID{first.Hash(), firstPartsHeader}
+			// Verify the commits of the blocks after the first in the background
+			// while the first one is executed.
+			bcR.scheduleVerifications(verifier, state)
+
 			// Finally, verify the first block using the second's commit
-			// NOTE: we can probably make this more efficient, but note that calling
-			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-			// currently necessary.
-			err := state.Validators.VerifyCommit(
-				chainID, firstID, first.Height, second.LastCommit)
+			firstParts, firstID, err := verifier.verify(first, second.LastCommit, state.Validators)
 			if err != nil {
 				bcR.Logger.Error("Error in validation", "err", err)
 				peerID := bcR.pool.RedoRequest(first.Height)
@@ -343,6 +349,10 @@ FOR_LOOP:
 				}
 				blocksSynced++
 
+				if bcR.haltIfNeeded(state) {
+					break FOR_LOOP
+				}
+
 				if blocksSynced%100 == 0 {
 					lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
 					bcR.Logger.Info("Fast Sync Rate", "height", bcR.pool.height,
@@ -358,6 +368,47 @@ FOR_LOOP:
 	}
 }
 
+// haltIfNeeded stops syncing without switching to consensus if the state
+// reached the halt height or time. It returns true if it did.
+func (bcR *BlockchainReactor) haltIfNeeded(state sm.State) bool {
+	halter := bcR.blockExec.Halter()
+	if !halter.ShouldHalt(state.LastBlockHeight, state.LastBlockTime) {
+		return false
+	}
+	bcR.Logger.Info("Halting fast sync", "height", state.LastBlockHeight,
+		"haltHeight", halter.HaltHeight(), "haltTime", halter.HaltTime())
+	bcR.pool.Stop()
+	halter.Halt()
+	return true
+}
+
+// scheduleVerifications schedules the verification of the commits of the
+// blocks waiting in the pool, for as long as their validator set is known from
+// state. Each block is verified using the next block's LastCommit.
+func (bcR *BlockchainReactor) scheduleVerifications(verifier *commitVerifier, state sm.State) {
+	blocks := bcR.pool.PeekBlocks(maxVerifyAheadBlocks + 1)
+	if len(blocks) < 2 {
+		return
+	}
+
+	valsHash := state.Validators.Hash()
+	nextValsHash := state.NextValidators.Hash()
+	for i := 0; i+1 < len(blocks); i++ {
+		block := blocks[i]
+		var vals *types.ValidatorSet
+		switch {
+		case bytes.Equal(block.ValidatorsHash, valsHash):
+			vals = state.Validators
+		case bytes.Equal(block.ValidatorsHash, nextValsHash):
+			vals = state.NextValidators
+		default:
+			// The validator set is not known until earlier blocks are applied.
+			return
+		}
+		verifier.schedule(block, blocks[i+1].LastCommit, vals)
+	}
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` height.
 func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
 	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{bcR.store.Height()})
@@ -56,7 +56,8 @@ func makeVote(header *types.Header, blockID types.BlockID, valset *types.Validat
 
 	privVal.SignVote(header.ChainID, vote)
 
-	return vote
+	// blocks carry no vote extensions
+	return vote.StripExtension()
 }
 
 type BlockchainReactorPair struct {
@@ -91,7 +92,7 @@ func newBlockchainReactor(logger log.Logger, genDoc *types.GenesisDoc, privVals
 	// pool.height is determined from the store.
 	fastSync := true
 	blockExec := sm.NewBlockExecutor(dbm.NewMemDB(), log.TestingLogger(), proxyApp.Consensus(),
-		sm.MockMempool{}, sm.MockEvidencePool{})
+		sm.MockMempool{}, sm.MockEvidencePool{}, blockStore)
 
 	// let's add some blocks in
 	for blockHeight := int64(1); blockHeight <= maxBlockHeight; blockHeight++ {
@@ -177,6 +178,92 @@ func TestNoBlockResponse(t *testing.T) {
 	}
 }
 
+func TestFastSyncHalt(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	maxBlockHeight := int64(65)
+	haltHeight := int64(10)
+
+	reactorPairs := make([]BlockchainReactorPair, 2)
+
+	reactorPairs[0] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	reactorPairs[1] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0)
+	halter := reactorPairs[1].reactor.blockExec.Halter()
+	halter.SetHalt(haltHeight, time.Time{})
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactorPairs[i].reactor)
+		return s
+
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			r.reactor.Stop()
+			r.app.Stop()
+		}
+	}()
+
+	select {
+	case <-halter.Halted():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the halt")
+	}
+
+	// no block is synced past the halt height
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, haltHeight, reactorPairs[1].reactor.store.Height())
+	assert.False(t, reactorPairs[1].reactor.pool.IsRunning())
+}
+
+// Ensure a node fast syncs over a slow link once the partition between it
+// and its peer heals
+func TestFastSyncAcrossPartition(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	maxBlockHeight := int64(65)
+
+	reactorPairs := make([]BlockchainReactorPair, 2)
+
+	reactorPairs[0] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	reactorPairs[1] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0)
+
+	network := p2p.NewSimNetwork(p2p.SimNetworkSeed(1))
+	defer network.Close()
+	network.SetDefaultLink(p2p.SimLink{Latency: 20 * time.Millisecond, Bandwidth: 1024 * 1024})
+	switches := p2p.MakeSimSwitches(config.P2P, network, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactorPairs[i].reactor)
+		return s
+
+	}, p2p.ConnectSimSwitches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			r.reactor.Stop()
+			r.app.Stop()
+		}
+	}()
+
+	// the status exchange is still in flight, so nothing is synced until the
+	// partition heals
+	network.Partition(p2p.SwitchIPs(switches[0]), p2p.SwitchIPs(switches[1]))
+	time.Sleep(200 * time.Millisecond)
+	assert.EqualValues(t, 0, reactorPairs[1].reactor.store.Height())
+
+	// the last block can't be verified without the next block's commit
+	network.Heal()
+	deadline := time.After(10 * time.Second)
+	for reactorPairs[1].reactor.store.Height() < maxBlockHeight-1 {
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for fast sync")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
 // NOTE: This is too hard to test without
 // an easy way to add test peer to switch
 // or without significant refactoring of the module.
@@ -263,7 +350,7 @@ func makeTxs(height int64) (txs []types.Tx) {
 }
 
 func makeBlock(height int64, state sm.State, lastCommit *types.Commit) *types.Block {
-	block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, state.Validators.GetProposer().Address)
+	block, _ := state.MakeBlock(height, makeTxs(height), lastCommit, nil, state.Validators.GetProposer().Address, tmtime.Now())
 	return block
 }
 
@@ -289,7 +376,7 @@ func (app *testApp) DeliverTx(tx []byte) abci.ResponseDeliverTx {
 	return abci.ResponseDeliverTx{Tags: []cmn.KVPair{}}
 }
 
-func (app *testApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+func (app *testApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	return abci.ResponseCheckTx{}
 }
 
@@ -123,6 +123,7 @@ func (bs *BlockStore) LoadBlockCommit(height int64) *types.Commit {
 // LoadSeenCommit returns the locally seen Commit for the given height.
 // This is useful when we've seen a commit, but there has not yet been
 // a new block at `height + 1` that includes this commit in its block.LastCommit.
+// Unlike the block commit, it may carry the vote extensions of the precommits.
 func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	var commit = new(types.Commit)
 	bz := bs.db.Get(calcSeenCommitKey(height))
@@ -142,6 +143,7 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 //             If all the nodes restart after committing a block,
 //             we need this to reload the precommits to catch-up nodes to the
 //             most recent height.  Otherwise they'd stall at H-1.
+//             It may carry vote extensions, which are kept as is.
 func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 	if block == nil {
 		cmn.PanicSanity("BlockStore can only save a non-nil block")
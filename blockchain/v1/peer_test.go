@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func TestPeerAddBlock(t *testing.T) {
+	peer := NewBpPeer("P1", 10, func(err error, _ p2p.ID) {}, nil)
+	defer peer.Cleanup()
+
+	// unsolicited block
+	assert.Equal(t, errMissingBlock, peer.AddBlock(makeTestBlock(1), 10))
+
+	peer.RequestSent(1)
+	peer.RequestSent(2)
+	assert.Equal(t, 2, peer.NumPendingBlockRequests)
+	_, err := peer.BlockAtHeight(1)
+	assert.Equal(t, errMissingBlock, err)
+
+	require.NoError(t, peer.AddBlock(makeTestBlock(1), 10))
+	assert.Equal(t, 1, peer.NumPendingBlockRequests)
+	block, err := peer.BlockAtHeight(1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), block.Height)
+
+	// duplicate block
+	assert.Equal(t, errDuplicateBlock, peer.AddBlock(makeTestBlock(1), 10))
+
+	peer.RemoveBlock(1)
+	_, err = peer.BlockAtHeight(1)
+	assert.Equal(t, errMissingBlock, err)
+}
+
+func TestPeerResponseTimeout(t *testing.T) {
+	var (
+		mtx      sync.Mutex
+		timedOut p2p.ID
+	)
+	params := BpPeerDefaultParams()
+	params.timeout = 50 * time.Millisecond
+	peer := NewBpPeer("P1", 10, func(err error, peerID p2p.ID) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		assert.Equal(t, errNoPeerResponse, err)
+		timedOut = peerID
+	}, params)
+	defer peer.Cleanup()
+
+	peer.RequestSent(1)
+	time.Sleep(100 * time.Millisecond)
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Equal(t, p2p.ID("P1"), timedOut)
+}
+
+func TestPeerScore(t *testing.T) {
+	onErr := func(err error, _ p2p.ID) {}
+	busy := NewBpPeer("A", 10, onErr, nil)
+	idle := NewBpPeer("B", 10, onErr, nil)
+	tie := NewBpPeer("C", 10, onErr, nil)
+	defer busy.Cleanup()
+	defer idle.Cleanup()
+	defer tie.Cleanup()
+
+	busy.RequestSent(1)
+	busy.RequestSent(2)
+	assert.True(t, idle.Score() > busy.Score())
+	assert.Equal(t, idle.Score(), tie.Score())
+
+	// peers with less pending requests come first, ties are broken by ID
+	peers := []*BpPeer{busy, tie, idle}
+	sortPeersByScore(peers)
+	assert.Equal(t, []p2p.ID{"B", "C", "A"}, []p2p.ID{peers[0].ID, peers[1].ID, peers[2].ID})
+}
@@ -0,0 +1,603 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+
+	bc "github.com/tendermint/tendermint/blockchain"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// BlockchainChannel is a channel for blocks and status updates (`BlockStore` height)
+	BlockchainChannel = byte(0x40)
+
+	trySyncIntervalMS = 10
+	trySendIntervalMS = 10
+
+	// ask for best height every 10s
+	statusUpdateIntervalSeconds = 10
+
+	// maximum number of block requests in flight, across all peers
+	maxNumRequests = 64
+	// maximum number of block requests in flight to a single peer
+	maxRequestsPerPeer = 20
+
+	// must be bigger than peers count
+	fsmChannelCapacity = 1000
+
+	// NOTE: keep up to date with bcBlockResponseMessage
+	bcBlockResponseMessagePrefixSize   = 4
+	bcBlockResponseMessageFieldKeySize = 1
+	maxMsgSize                         = types.MaxBlockSizeBytes +
+		bcBlockResponseMessagePrefixSize +
+		bcBlockResponseMessageFieldKeySize
+)
+
+type consensusReactor interface {
+	// for when we switch from blockchain reactor and fast sync to
+	// the consensus machine
+	SwitchToConsensus(sm.State, int)
+}
+
+// bcReactorMessage is an event for the FSM, sent by the reactor.
+type bcReactorMessage struct {
+	event bReactorEvent
+	data  bReactorEventData
+}
+
+type peerError struct {
+	err    error
+	peerID p2p.ID
+}
+
+func (e peerError) Error() string {
+	return fmt.Sprintf("error with peer %v: %s", e.peerID, e.err.Error())
+}
+
+// BlockchainReactor handles long-term catchup syncing. Unlike the v0 reactor,
+// all the fast sync logic lives in a state machine (BcReactorFSM) which the
+// reactor feeds with events; the reactor only does the I/O.
+type BlockchainReactor struct {
+	p2p.BaseReactor
+
+	initialState sm.State // immutable
+	state        sm.State
+
+	blockExec *sm.BlockExecutor
+	store     *bc.BlockStore
+
+	fastSync     bool
+	syncing      int32 // atomic, 1 while the FSM is running
+	blocksSynced int
+
+	fsm        *BcReactorFSM
+	stateTimer *time.Timer // only accessed from poolRoutine
+
+	// Receive and RemovePeer forward peer messages and removals to this channel,
+	// to be processed by the FSM in the poolRoutine.
+	messagesForFSMCh chan bcReactorMessage
+
+	// Timers report timeouts to this channel, to be processed by the FSM in
+	// the poolRoutine.
+	errorsForFSMCh chan bcReactorMessage
+
+	// The FSM and peer timers report peer errors to this channel. They are
+	// relayed to the switch by the poolRoutine.
+	errorsFromFSMCh chan peerError
+}
+
+// NewBlockchainReactor returns new reactor instance.
+func NewBlockchainReactor(state sm.State, blockExec *sm.BlockExecutor, store *bc.BlockStore,
+	fastSync bool) *BlockchainReactor {
+
+	if state.LastBlockHeight != store.Height() {
+		panic(fmt.Sprintf("state (%v) and store (%v) height mismatch", state.LastBlockHeight,
+			store.Height()))
+	}
+
+	bcR := &BlockchainReactor{
+		initialState:     state,
+		state:            state,
+		blockExec:        blockExec,
+		store:            store,
+		fastSync:         fastSync,
+		messagesForFSMCh: make(chan bcReactorMessage, fsmChannelCapacity),
+		errorsForFSMCh:   make(chan bcReactorMessage, fsmChannelCapacity),
+		errorsFromFSMCh:  make(chan peerError, fsmChannelCapacity),
+	}
+	bcR.fsm = NewFSM(store.Height()+1, bcR)
+	bcR.BaseReactor = *p2p.NewBaseReactor("BlockchainReactor", bcR)
+	return bcR
+}
+
+// SetLogger implements cmn.Service by setting the logger on reactor and FSM.
+func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
+	bcR.BaseService.Logger = l
+	bcR.fsm.SetLogger(l)
+}
+
+// OnStart implements cmn.Service.
+func (bcR *BlockchainReactor) OnStart() error {
+	if bcR.fastSync {
+		atomic.StoreInt32(&bcR.syncing, 1)
+		go bcR.poolRoutine()
+	}
+	return nil
+}
+
+// OnStop implements cmn.Service.
+func (bcR *BlockchainReactor) OnStop() {}
+
+// GetChannels implements Reactor
+func (bcR *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  BlockchainChannel,
+			Priority:            10,
+			SendQueueCapacity:   1000,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+		},
+	}
+}
+
+// AddPeer implements Reactor by sending our state to peer.
+func (bcR *BlockchainReactor) AddPeer(peer p2p.Peer) {
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+	if !peer.Send(BlockchainChannel, msgBytes) {
+		// doing nothing, will try later in `poolRoutine`
+	}
+	// peer is added to the FSM once we receive the first
+	// bcStatusResponseMessage from the peer
+}
+
+// RemovePeer implements Reactor by removing peer from the FSM.
+func (bcR *BlockchainReactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	bcR.sendToFSM(bcR.errorsForFSMCh, bcReactorMessage{
+		event: peerRemoveEv,
+		data: bReactorEventData{
+			peerID: peer.ID(),
+		},
+	})
+}
+
+// sendBlockToPeer loads a block and sends it to the requesting peer,
+// if we have it. Otherwise, we'll respond saying we don't have it.
+func (bcR *BlockchainReactor) sendBlockToPeer(msg *bcBlockRequestMessage,
+	src p2p.Peer) (queued bool) {
+
+	block := bcR.store.LoadBlock(msg.Height)
+	if block != nil {
+		msgBytes := cdc.MustMarshalBinaryBare(&bcBlockResponseMessage{Block: block})
+		return src.TrySend(BlockchainChannel, msgBytes)
+	}
+
+	bcR.Logger.Info("peer asking for a block we don't have", "src", src, "height", msg.Height)
+
+	msgBytes := cdc.MustMarshalBinaryBare(&bcNoBlockResponseMessage{Height: msg.Height})
+	return src.TrySend(BlockchainChannel, msgBytes)
+}
+
+func (bcR *BlockchainReactor) sendStatusResponseToPeer(msg *bcStatusRequestMessage, src p2p.Peer) (queued bool) {
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusResponseMessage{bcR.store.Height()})
+	return src.TrySend(BlockchainChannel, msgBytes)
+}
+
+// Receive implements Reactor by handling 4 types of messages (look below).
+func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		bcR.Logger.Error("error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
+		bcR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		bcR.Logger.Error("peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		bcR.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	bcR.Logger.Debug("Receive", "src", src, "chID", chID, "msg", msg)
+
+	switch msg := msg.(type) {
+	case *bcBlockRequestMessage:
+		if queued := bcR.sendBlockToPeer(msg, src); !queued {
+			// Unfortunately not queued since the queue is full.
+			bcR.Logger.Error("Could not send block message to peer", "src", src, "height", msg.Height)
+		}
+
+	case *bcStatusRequestMessage:
+		// Send peer our state.
+		if queued := bcR.sendStatusResponseToPeer(msg, src); !queued {
+			// Unfortunately not queued since the queue is full.
+			bcR.Logger.Error("Could not send status message to peer", "src", src)
+		}
+
+	case *bcBlockResponseMessage:
+		bcR.sendToFSM(bcR.messagesForFSMCh, bcReactorMessage{
+			event: blockResponseEv,
+			data: bReactorEventData{
+				peerID: src.ID(),
+				height: msg.Block.Height,
+				block:  msg.Block,
+				length: len(msgBytes),
+			},
+		})
+
+	case *bcNoBlockResponseMessage:
+		bcR.sendToFSM(bcR.messagesForFSMCh, bcReactorMessage{
+			event: noBlockResponseEv,
+			data: bReactorEventData{
+				peerID: src.ID(),
+				height: msg.Height,
+			},
+		})
+
+	case *bcStatusResponseMessage:
+		// Got a peer status. Unverified.
+		bcR.sendToFSM(bcR.messagesForFSMCh, bcReactorMessage{
+			event: statusResponseEv,
+			data: bReactorEventData{
+				peerID: src.ID(),
+				height: msg.Height,
+				length: len(msgBytes),
+			},
+		})
+
+	default:
+		bcR.Logger.Error(fmt.Sprintf("unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// sendToFSM queues an event for the FSM, unless fast sync isn't running.
+func (bcR *BlockchainReactor) sendToFSM(ch chan bcReactorMessage, msg bcReactorMessage) {
+	if atomic.LoadInt32(&bcR.syncing) == 0 {
+		return
+	}
+	select {
+	case ch <- msg:
+	case <-bcR.Quit():
+	}
+}
+
+// poolRoutine runs the FSM: it feeds it the events received from peers, the
+// switch and timers, makes it send block requests, and processes the blocks
+// it has received, until fast sync is finished or the reactor is stopped.
+// NOTE: Don't sleep in the ForLoop or otherwise slow it down!
+func (bcR *BlockchainReactor) poolRoutine() {
+	defer atomic.StoreInt32(&bcR.syncing, 0)
+
+	// We may have restarted after halting.
+	if bcR.haltIfNeeded() {
+		return
+	}
+
+	bcR.fsm.Start()
+
+	processReceivedBlockTicker := time.NewTicker(trySyncIntervalMS * time.Millisecond)
+	sendBlockRequestTicker := time.NewTicker(trySendIntervalMS * time.Millisecond)
+	statusUpdateTicker := time.NewTicker(statusUpdateIntervalSeconds * time.Second)
+	defer processReceivedBlockTicker.Stop()
+	defer sendBlockRequestTicker.Stop()
+	defer statusUpdateTicker.Stop()
+	defer func() {
+		if bcR.stateTimer != nil {
+			bcR.stateTimer.Stop()
+		}
+	}()
+
+	doProcessBlockCh := make(chan struct{}, 1)
+
+	lastHundred := time.Now()
+	lastRate := 0.0
+
+ForLoop:
+	for !bcR.fsm.isCaughtUp() {
+		select {
+		case <-sendBlockRequestTicker.C:
+			if !bcR.fsm.NeedsBlocks() {
+				continue
+			}
+			_ = bcR.fsm.Handle(&bcReactorMessage{
+				event: makeRequestsEv,
+				data: bReactorEventData{
+					maxNumRequests: maxNumRequests}})
+
+		case <-statusUpdateTicker.C:
+			// Ask for status updates.
+			go bcR.sendStatusRequest()
+
+		case <-processReceivedBlockTicker.C: // chan time
+			select {
+			case doProcessBlockCh <- struct{}{}:
+			default:
+			}
+
+		case <-doProcessBlockCh:
+			err := bcR.processBlock()
+			if err == errMissingBlock {
+				continue
+			}
+			// Stop before the FSM gets the chance to switch to consensus.
+			if err == nil && bcR.haltIfNeeded() {
+				break ForLoop
+			}
+			// Notify FSM of block processing result.
+			_ = bcR.fsm.Handle(&bcReactorMessage{
+				event: processedBlockEv,
+				data: bReactorEventData{
+					err: err,
+				},
+			})
+			if err != nil {
+				continue
+			}
+			// Try again quickly next loop.
+			doProcessBlockCh <- struct{}{}
+
+			bcR.blocksSynced++
+			if bcR.blocksSynced%100 == 0 {
+				height, maxPeerHeight := bcR.fsm.Status()
+				lastRate = 0.9*lastRate + 0.1*(100/time.Since(lastHundred).Seconds())
+				bcR.Logger.Info("Fast Sync Rate", "height", height,
+					"max_peer_height", maxPeerHeight, "blocks/s", lastRate)
+				lastHundred = time.Now()
+			}
+
+		case msg := <-bcR.messagesForFSMCh:
+			// Sent from the Receive() routine when status (statusResponseEv) and
+			// block (blockResponseEv) response events are received
+			_ = bcR.fsm.Handle(&msg)
+
+		case msg := <-bcR.errorsForFSMCh:
+			// Sent from the switch.RemovePeer() routine (peerRemoveEv) and
+			// FSM state timer expiry routine (stateTimeoutEv).
+			_ = bcR.fsm.Handle(&msg)
+
+		case err := <-bcR.errorsFromFSMCh:
+			bcR.reportPeerErrorToSwitch(err.err, err.peerID)
+
+		case <-bcR.Quit():
+			break ForLoop
+		}
+	}
+}
+
+// haltIfNeeded returns true if the state reached the halt height or time, in
+// which case it stops the state machine and its pool, signals the halt, and
+// fast sync must stop without switching to consensus.
+func (bcR *BlockchainReactor) haltIfNeeded() bool {
+	halter := bcR.blockExec.Halter()
+	if !halter.ShouldHalt(bcR.state.LastBlockHeight, bcR.state.LastBlockTime) {
+		return false
+	}
+	bcR.Logger.Info("Halting fast sync", "height", bcR.state.LastBlockHeight,
+		"haltHeight", halter.HaltHeight(), "haltTime", halter.HaltTime())
+	if bcR.stateTimer != nil {
+		bcR.stateTimer.Stop()
+	}
+	bcR.fsm.Halt()
+	halter.Halt()
+	return true
+}
+
+func (bcR *BlockchainReactor) reportPeerErrorToSwitch(err error, peerID p2p.ID) {
+	peer := bcR.Switch.Peers().Get(peerID)
+	if peer != nil {
+		bcR.Switch.StopPeerForError(peer, err)
+	}
+}
+
+// processBlock verifies the first block against the second one's commit and,
+// if valid, saves and applies it. It returns errMissingBlock if either block
+// hasn't been received yet.
+func (bcR *BlockchainReactor) processBlock() error {
+	first, second, err := bcR.fsm.FirstTwoBlocks()
+	if err != nil {
+		// We need both to sync the first block.
+		return err
+	}
+
+	chainID := bcR.initialState.ChainID
+
+	firstParts := first.MakePartSet(types.BlockPartSizeBytes)
+	firstPartsHeader := firstParts.Header()
+	firstID := types.BlockID{Hash: first.Hash(), PartsHeader: firstPartsHeader}
+	// Finally, verify the first block using the second's commit
+	// NOTE: we can probably make this more efficient, but note that calling
+	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	err = bcR.state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
+	if err != nil {
+		bcR.Logger.Error("error during commit verification", "err", err,
+			"first", first.Height, "second", second.Height)
+		return errBlockVerificationFailure
+	}
+
+	bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+
+	bcR.state, err = bcR.blockExec.ApplyBlock(bcR.state, firstID, first)
+	if err != nil {
+		panic(fmt.Sprintf("failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
+	}
+
+	return nil
+}
+
+// Implements bcReactor interface
+func (bcR *BlockchainReactor) sendStatusRequest() {
+	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{bcR.store.Height()})
+	bcR.Switch.Broadcast(BlockchainChannel, msgBytes)
+}
+
+// Implements bcReactor interface
+func (bcR *BlockchainReactor) sendBlockRequest(peerID p2p.ID, height int64) error {
+	peer := bcR.Switch.Peers().Get(peerID)
+	if peer == nil {
+		return errNilPeerForBlockRequest
+	}
+
+	msgBytes := cdc.MustMarshalBinaryBare(&bcBlockRequestMessage{height})
+	queued := peer.TrySend(BlockchainChannel, msgBytes)
+	if !queued {
+		return errSendQueueFull
+	}
+	return nil
+}
+
+// Implements bcReactor interface. Called from the poolRoutine and the peer
+// timers, so it must not block.
+func (bcR *BlockchainReactor) sendPeerError(err error, peerID p2p.ID) {
+	select {
+	case bcR.errorsFromFSMCh <- peerError{err, peerID}:
+	default:
+		bcR.Logger.Error("dropping peer error, channel is full", "peer", peerID, "err", err)
+	}
+}
+
+// Implements bcReactor interface
+func (bcR *BlockchainReactor) resetStateTimer(name string, timeout time.Duration) {
+	if bcR.stateTimer != nil {
+		bcR.stateTimer.Stop()
+	}
+	bcR.stateTimer = time.AfterFunc(timeout, func() {
+		bcR.sendToFSM(bcR.errorsForFSMCh, bcReactorMessage{
+			event: stateTimeoutEv,
+			data: bReactorEventData{
+				stateName: name,
+			},
+		})
+	})
+}
+
+// Implements bcReactor interface
+func (bcR *BlockchainReactor) switchToConsensus() {
+	conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
+	if ok {
+		conR.SwitchToConsensus(bcR.state, bcR.blocksSynced)
+	}
+	// else: should only happen during testing
+}
+
+//-----------------------------------------------------------------------------
+// Messages
+
+// BlockchainMessage is a generic message for this reactor.
+type BlockchainMessage interface {
+	ValidateBasic() error
+}
+
+// RegisterBlockchainMessages registers the fast sync messages for amino
+// encoding. The names are shared with the v0 reactor, so that nodes running
+// either version can sync from each other.
+func RegisterBlockchainMessages(cdc *amino.Codec) {
+	cdc.RegisterInterface((*BlockchainMessage)(nil), nil)
+	cdc.RegisterConcrete(&bcBlockRequestMessage{}, "tendermint/blockchain/BlockRequest", nil)
+	cdc.RegisterConcrete(&bcBlockResponseMessage{}, "tendermint/blockchain/BlockResponse", nil)
+	cdc.RegisterConcrete(&bcNoBlockResponseMessage{}, "tendermint/blockchain/NoBlockResponse", nil)
+	cdc.RegisterConcrete(&bcStatusResponseMessage{}, "tendermint/blockchain/StatusResponse", nil)
+	cdc.RegisterConcrete(&bcStatusRequestMessage{}, "tendermint/blockchain/StatusRequest", nil)
+}
+
+func decodeMsg(bz []byte) (msg BlockchainMessage, err error) {
+	if len(bz) > maxMsgSize {
+		return msg, fmt.Errorf("msg exceeds max size (%d > %d)", len(bz), maxMsgSize)
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &msg)
+	return
+}
+
+//-------------------------------------
+
+type bcBlockRequestMessage struct {
+	Height int64
+}
+
+// ValidateBasic performs basic validation.
+func (m *bcBlockRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	return nil
+}
+
+func (m *bcBlockRequestMessage) String() string {
+	return fmt.Sprintf("[bcBlockRequestMessage %v]", m.Height)
+}
+
+type bcNoBlockResponseMessage struct {
+	Height int64
+}
+
+// ValidateBasic performs basic validation.
+func (m *bcNoBlockResponseMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	return nil
+}
+
+func (m *bcNoBlockResponseMessage) String() string {
+	return fmt.Sprintf("[bcNoBlockResponseMessage %d]", m.Height)
+}
+
+//-------------------------------------
+
+type bcBlockResponseMessage struct {
+	Block *types.Block
+}
+
+// ValidateBasic performs basic validation.
+func (m *bcBlockResponseMessage) ValidateBasic() error {
+	return m.Block.ValidateBasic()
+}
+
+func (m *bcBlockResponseMessage) String() string {
+	return fmt.Sprintf("[bcBlockResponseMessage %v]", m.Block.Height)
+}
+
+//-------------------------------------
+
+type bcStatusRequestMessage struct {
+	Height int64
+}
+
+// ValidateBasic performs basic validation.
+func (m *bcStatusRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	return nil
+}
+
+func (m *bcStatusRequestMessage) String() string {
+	return fmt.Sprintf("[bcStatusRequestMessage %v]", m.Height)
+}
+
+//-------------------------------------
+
+type bcStatusResponseMessage struct {
+	Height int64
+}
+
+// ValidateBasic performs basic validation.
+func (m *bcStatusResponseMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	return nil
+}
+
+func (m *bcStatusResponseMessage) String() string {
+	return fmt.Sprintf("[bcStatusResponseMessage %v]", m.Height)
+}
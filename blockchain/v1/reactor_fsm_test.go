@@ -0,0 +1,357 @@
+package v1
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testBcR implements bcReactor, recording what the FSM asks it to do. State
+// timeouts are not run, tests send stateTimeoutEv themselves.
+type testBcR struct {
+	numStatusRequests int
+	blockRequests     map[int64]p2p.ID
+	stateTimers       []string
+	switchedToCons    bool
+
+	// peer timers may report errors from their own goroutines
+	mtx        sync.Mutex
+	peerErrors map[p2p.ID]error
+
+	// peers that aren't known to the "switch"
+	missingPeers map[p2p.ID]struct{}
+}
+
+var _ bcReactor = (*testBcR)(nil)
+
+func newTestBcR() *testBcR {
+	return &testBcR{
+		blockRequests: make(map[int64]p2p.ID),
+		peerErrors:    make(map[p2p.ID]error),
+		missingPeers:  make(map[p2p.ID]struct{}),
+	}
+}
+
+func (r *testBcR) sendStatusRequest() {
+	r.numStatusRequests++
+}
+
+func (r *testBcR) sendBlockRequest(peerID p2p.ID, height int64) error {
+	if _, ok := r.missingPeers[peerID]; ok {
+		return errNilPeerForBlockRequest
+	}
+	r.blockRequests[height] = peerID
+	return nil
+}
+
+func (r *testBcR) sendPeerError(err error, peerID p2p.ID) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.peerErrors[peerID] = err
+}
+
+func (r *testBcR) peerError(peerID p2p.ID) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.peerErrors[peerID]
+}
+
+func (r *testBcR) numPeerErrors() int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return len(r.peerErrors)
+}
+
+func (r *testBcR) resetStateTimer(name string, timeout time.Duration) {
+	r.stateTimers = append(r.stateTimers, name)
+}
+
+func (r *testBcR) switchToConsensus() {
+	r.switchedToCons = true
+}
+
+//-----------------------------------------------------------------------------
+
+func newTestFSM(height int64) (*BcReactorFSM, *testBcR) {
+	bcR := newTestBcR()
+	fsm := NewFSM(height, bcR)
+	fsm.SetLogger(log.TestingLogger())
+	return fsm, bcR
+}
+
+func makeTestBlock(height int64) *types.Block {
+	return types.MakeBlock(height, []types.Tx{types.Tx(fmt.Sprintf("tx%d", height))}, nil, nil)
+}
+
+func sStatusEv(peerID p2p.ID, height int64) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: statusResponseEv,
+		data:  bReactorEventData{peerID: peerID, height: height},
+	}
+}
+
+func sBlockRespEv(peerID p2p.ID, height int64) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: blockResponseEv,
+		data: bReactorEventData{
+			peerID: peerID,
+			height: height,
+			block:  makeTestBlock(height),
+			length: 10,
+		},
+	}
+}
+
+func sProcessedBlockEv(err error) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: processedBlockEv,
+		data:  bReactorEventData{err: err},
+	}
+}
+
+func sMakeRequestsEv(maxNumRequests int) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: makeRequestsEv,
+		data:  bReactorEventData{maxNumRequests: maxNumRequests},
+	}
+}
+
+func sPeerRemoveEv(peerID p2p.ID, err error) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: peerRemoveEv,
+		data:  bReactorEventData{peerID: peerID, err: err},
+	}
+}
+
+func sStateTimeoutEv(state string) *bcReactorMessage {
+	return &bcReactorMessage{
+		event: stateTimeoutEv,
+		data:  bReactorEventData{stateName: state},
+	}
+}
+
+// deliverRequestedBlocks answers all the block requests made so far.
+func deliverRequestedBlocks(t *testing.T, fsm *BcReactorFSM, bcR *testBcR) {
+	for height, peerID := range bcR.blockRequests {
+		require.NoError(t, fsm.Handle(sBlockRespEv(peerID, height)))
+		delete(bcR.blockRequests, height)
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+func TestFSMStartWaitsForPeer(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	assert.Equal(t, waitForPeer, fsm.state)
+	assert.Equal(t, 1, bcR.numStatusRequests)
+	assert.Equal(t, []string{"waitForPeer"}, bcR.stateTimers)
+
+	// no peer answers, fast sync is done
+	assert.Equal(t, errNoTallerPeer, fsm.Handle(sStateTimeoutEv("waitForPeer")))
+	assert.Equal(t, finished, fsm.state)
+	assert.True(t, bcR.switchedToCons)
+}
+
+func TestFSMIgnoresShortPeers(t *testing.T) {
+	fsm, bcR := newTestFSM(10)
+	fsm.Start()
+
+	assert.Equal(t, errPeerTooShort, fsm.Handle(sStatusEv("P1", 5)))
+	assert.Equal(t, waitForPeer, fsm.state)
+
+	assert.NoError(t, fsm.Handle(sStatusEv("P2", 20)))
+	assert.Equal(t, waitForBlock, fsm.state)
+	assert.Equal(t, []string{"waitForPeer", "waitForBlock"}, bcR.stateTimers)
+}
+
+func TestFSMWrongStateTimeout(t *testing.T) {
+	fsm, _ := newTestFSM(1)
+	fsm.Start()
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+
+	assert.Equal(t, errTimeoutEventWrongState, fsm.Handle(sStateTimeoutEv("waitForPeer")))
+	assert.Equal(t, waitForBlock, fsm.state)
+}
+
+func TestFSMSyncsToMaxPeerHeight(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 30)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 30)))
+	require.NoError(t, fsm.Handle(sStatusEv("P3", 25)))
+
+	for !fsm.isCaughtUp() {
+		require.NoError(t, fsm.Handle(sMakeRequestsEv(maxNumRequests)))
+		deliverRequestedBlocks(t, fsm, bcR)
+		for {
+			_, _, err := fsm.FirstTwoBlocks()
+			if err != nil {
+				break
+			}
+			require.NoError(t, fsm.Handle(sProcessedBlockEv(nil)))
+			if fsm.isCaughtUp() {
+				break
+			}
+		}
+	}
+
+	assert.True(t, bcR.switchedToCons)
+	assert.Zero(t, bcR.numPeerErrors())
+}
+
+func TestFSMPipelinesRequests(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 100)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 100)))
+
+	// each peer gets at most maxRequestsPerPeer requests
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(maxNumRequests)))
+	assert.Len(t, bcR.blockRequests, 2*maxRequestsPerPeer)
+	perPeer := make(map[p2p.ID]int)
+	for _, peerID := range bcR.blockRequests {
+		perPeer[peerID]++
+	}
+	assert.Equal(t, maxRequestsPerPeer, perPeer["P1"])
+	assert.Equal(t, maxRequestsPerPeer, perPeer["P2"])
+
+	// requests are made for the lowest heights first
+	for h := int64(1); h <= 2*maxRequestsPerPeer; h++ {
+		assert.Contains(t, bcR.blockRequests, h)
+	}
+
+	// once blocks are received new requests are made
+	deliverRequestedBlocks(t, fsm, bcR)
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(maxNumRequests)))
+	assert.Len(t, bcR.blockRequests, maxNumRequests-2*maxRequestsPerPeer)
+}
+
+func TestFSMBansPeersSendingInvalidBlocks(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 10)))
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(2)))
+	deliverRequestedBlocks(t, fsm, bcR)
+
+	_, _, err := fsm.FirstTwoBlocks()
+	require.NoError(t, err)
+
+	// both peers sent the first two blocks and are banned
+	assert.Equal(t, errBlockVerificationFailure,
+		fsm.Handle(sProcessedBlockEv(errBlockVerificationFailure)))
+	assert.Equal(t, errBlockVerificationFailure, bcR.peerError("P1"))
+	assert.Equal(t, errBlockVerificationFailure, bcR.peerError("P2"))
+	assert.Equal(t, waitForPeer, fsm.state)
+	assert.Equal(t, int64(1), fsm.pool.Height)
+
+	// banned peers are not added back
+	assert.Equal(t, errBannedPeer, fsm.Handle(sStatusEv("P1", 10)))
+	assert.Equal(t, waitForPeer, fsm.state)
+	assert.NoError(t, fsm.Handle(sStatusEv("P3", 10)))
+	assert.Equal(t, waitForBlock, fsm.state)
+}
+
+func TestFSMRemovesPeerSendingUnsolicitedBlock(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 10)))
+
+	assert.Equal(t, errMissingBlock, fsm.Handle(sBlockRespEv("P1", 5)))
+	assert.Equal(t, errMissingBlock, bcR.peerError("P1"))
+	assert.Equal(t, 1, fsm.pool.NumPeers())
+
+	// a block requested from another peer
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(1)))
+	require.Equal(t, p2p.ID("P2"), bcR.blockRequests[1])
+	require.NoError(t, fsm.Handle(sStatusEv("P3", 10)))
+	assert.Equal(t, errBadDataFromPeer, fsm.Handle(sBlockRespEv("P3", 1)))
+	assert.Equal(t, errBadDataFromPeer, bcR.peerError("P3"))
+}
+
+func TestFSMPeerLowersHeight(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 20)))
+	assert.Equal(t, int64(20), fsm.pool.MaxPeerHeight)
+
+	assert.Equal(t, errPeerLowersItsHeight, fsm.Handle(sStatusEv("P2", 15)))
+	assert.Equal(t, errPeerLowersItsHeight, bcR.peerError("P2"))
+	assert.Equal(t, int64(10), fsm.pool.MaxPeerHeight)
+}
+
+func TestFSMReschedulesRequestsOfRemovedPeer(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(5)))
+	require.Len(t, bcR.blockRequests, 5)
+
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 10)))
+	require.NoError(t, fsm.Handle(sPeerRemoveEv("P1", nil)))
+	assert.Equal(t, 1, fsm.pool.NumPeers())
+	assert.Zero(t, bcR.numPeerErrors(), "peers removed by the switch aren't reported")
+
+	bcR.blockRequests = make(map[int64]p2p.ID)
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(5)))
+	for h := int64(1); h <= 5; h++ {
+		assert.Equal(t, p2p.ID("P2"), bcR.blockRequests[h])
+	}
+
+	// no peers left
+	require.NoError(t, fsm.Handle(sPeerRemoveEv("P2", nil)))
+	assert.Equal(t, waitForPeer, fsm.state)
+}
+
+func TestFSMRemovesPeerNotInSwitch(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 10)))
+	bcR.missingPeers["P1"] = struct{}{}
+
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(5)))
+	for h := int64(1); h <= 5; h++ {
+		assert.Equal(t, p2p.ID("P2"), bcR.blockRequests[h])
+	}
+	assert.Equal(t, 1, fsm.pool.NumPeers())
+}
+
+func TestFSMBlockTimeoutRemovesSlowPeer(t *testing.T) {
+	fsm, bcR := newTestFSM(1)
+	fsm.Start()
+
+	require.NoError(t, fsm.Handle(sStatusEv("P1", 10)))
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(1)))
+	require.Equal(t, p2p.ID("P1"), bcR.blockRequests[1])
+	require.NoError(t, fsm.Handle(sStatusEv("P2", 10)))
+	require.NoError(t, fsm.Handle(sMakeRequestsEv(2)))
+	require.Equal(t, p2p.ID("P2"), bcR.blockRequests[2])
+
+	// P2 delivers block 2, P1 never delivers block 1
+	require.NoError(t, fsm.Handle(sBlockRespEv("P2", 2)))
+	assert.Equal(t, errNoPeerResponseForCurrentHeights,
+		fsm.Handle(sStateTimeoutEv("waitForBlock")))
+	assert.Equal(t, errNoPeerResponseForCurrentHeights, bcR.peerError("P1"))
+	assert.NoError(t, bcR.peerError("P2"))
+	assert.Equal(t, waitForBlock, fsm.state)
+}
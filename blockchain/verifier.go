@@ -0,0 +1,176 @@
+package blockchain
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// maximum number of blocks ahead of the pool height whose commits are
+	// verified before the block is executed.
+	maxVerifyAheadBlocks = 100
+)
+
+// commitVerifier verifies the commits of blocks waiting in the pool on a
+// bounded number of worker goroutines, ahead of the blocks' execution.
+// Blocks are still applied strictly sequentially by the poolRoutine, which
+// picks up the result of a verification with verify. A result is only reused
+// if it was computed for the same block, commit and validator set; otherwise
+// the commit is verified synchronously.
+type commitVerifier struct {
+	chainID string
+
+	mtx      sync.Mutex
+	verifies map[int64]*commitVerification
+
+	jobs chan *commitVerification
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// commitVerification is the verification of a block's commit. The result
+// fields are set by a worker before done is closed.
+type commitVerification struct {
+	block  *types.Block
+	commit *types.Commit
+	vals   *types.ValidatorSet
+
+	done     chan struct{}
+	valsHash []byte
+	parts    *types.PartSet
+	blockID  types.BlockID
+	err      error
+}
+
+// newCommitVerifier returns a verifier using up to numWorkers goroutines. If
+// numWorkers is not positive, the number of CPUs is used.
+func newCommitVerifier(chainID string, numWorkers int) *commitVerifier {
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+	cv := &commitVerifier{
+		chainID:  chainID,
+		verifies: make(map[int64]*commitVerification),
+		jobs:     make(chan *commitVerification, maxVerifyAheadBlocks),
+		quit:     make(chan struct{}),
+	}
+	cv.wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go cv.worker()
+	}
+	return cv
+}
+
+// stop terminates the workers and waits for them to exit. Verifications
+// still queued are abandoned.
+func (cv *commitVerifier) stop() {
+	close(cv.quit)
+	cv.wg.Wait()
+}
+
+func (cv *commitVerifier) worker() {
+	defer cv.wg.Done()
+	for {
+		select {
+		case v := <-cv.jobs:
+			v.run(cv.chainID)
+		case <-cv.quit:
+			return
+		}
+	}
+}
+
+// schedule queues the verification of block using commit and vals. Nothing
+// is done if a verification for the block's height is already in progress,
+// or completed for the same block and commit. The verification is dropped if
+// the queue is full.
+func (cv *commitVerifier) schedule(block *types.Block, commit *types.Commit, vals *types.ValidatorSet) {
+	cv.mtx.Lock()
+	defer cv.mtx.Unlock()
+
+	if v, ok := cv.verifies[block.Height]; ok {
+		select {
+		case <-v.done:
+			if v.block == block && v.commit == commit {
+				return
+			}
+		default:
+			return
+		}
+	}
+
+	v := newCommitVerification(block, commit, vals)
+	select {
+	case cv.jobs <- v:
+		cv.verifies[block.Height] = v
+	default:
+		delete(cv.verifies, block.Height)
+	}
+}
+
+// verify returns the parts and ID of block, and whether commit is a valid
+// commit for it by vals. It waits for a matching scheduled verification to
+// complete, or verifies the commit itself. Verifications for heights up to
+// the block height are forgotten.
+func (cv *commitVerifier) verify(block *types.Block, commit *types.Commit,
+	vals *types.ValidatorSet) (*types.PartSet, types.BlockID, error) {
+
+	valsHash := vals.Hash()
+
+	cv.mtx.Lock()
+	v, ok := cv.verifies[block.Height]
+	for height := range cv.verifies {
+		if height <= block.Height {
+			delete(cv.verifies, height)
+		}
+	}
+	cv.mtx.Unlock()
+
+	if ok {
+		// NOTE: wait even if the verification doesn't match, since commits
+		// lazily cache some of their fields and must not be verified
+		// concurrently.
+		select {
+		case <-v.done:
+			if v.matches(block, commit, valsHash) {
+				return v.parts, v.blockID, v.err
+			}
+		case <-cv.quit:
+		}
+	}
+
+	v = newCommitVerification(block, commit, vals)
+	v.run(cv.chainID)
+	return v.parts, v.blockID, v.err
+}
+
+func newCommitVerification(block *types.Block, commit *types.Commit,
+	vals *types.ValidatorSet) *commitVerification {
+
+	return &commitVerification{
+		block:  block,
+		commit: commit,
+		// NOTE: the validator set caches its total voting power, so every
+		// verification gets its own copy.
+		vals: vals.Copy(),
+		done: make(chan struct{}),
+	}
+}
+
+func (v *commitVerification) matches(block *types.Block, commit *types.Commit, valsHash []byte) bool {
+	return v.block == block && v.commit == commit && bytes.Equal(v.valsHash, valsHash)
+}
+
+func (v *commitVerification) run(chainID string) {
+	v.valsHash = v.vals.Hash()
+	// NOTE: we can probably make this more efficient, but note that calling
+	// block.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	v.parts = v.block.MakePartSet(types.BlockPartSizeBytes)
+	v.blockID = types.BlockID{Hash: v.block.Hash(), PartsHeader: v.parts.Header()}
+	v.err = v.vals.VerifyCommit(chainID, v.blockID, v.block.Height, v.commit)
+	close(v.done)
+}
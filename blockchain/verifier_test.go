@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const verifierTestChainID = "verifier_test"
+
+// makeVerifierTestChain returns numBlocks blocks starting at height 1, each
+// committed by vals in the LastCommit of the following block, which is
+// returned as well.
+func makeVerifierTestChain(t *testing.T, numBlocks int, vals *types.ValidatorSet,
+	privVals []types.PrivValidator) []*types.Block {
+
+	blocks := make([]*types.Block, 0, numBlocks+1)
+	lastCommit := new(types.Commit)
+	for height := int64(1); height <= int64(numBlocks)+1; height++ {
+		block := types.MakeBlock(height, makeTxs(height), lastCommit, nil)
+		block.ChainID = verifierTestChainID
+		block.ValidatorsHash = vals.Hash()
+		blocks = append(blocks, block)
+
+		parts := block.MakePartSet(types.BlockPartSizeBytes)
+		blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+		voteSet := types.NewVoteSet(verifierTestChainID, height, 0, types.PrecommitType, vals)
+		commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+		require.NoError(t, err)
+		lastCommit = commit
+	}
+	return blocks
+}
+
+func TestCommitVerifierReusesScheduledVerifications(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	blocks := makeVerifierTestChain(t, 10, vals, privVals)
+
+	verifier := newCommitVerifier(verifierTestChainID, 2)
+	defer verifier.stop()
+
+	for i := 0; i+1 < len(blocks); i++ {
+		verifier.schedule(blocks[i], blocks[i+1].LastCommit, vals)
+	}
+	scheduled := make([]*commitVerification, 0, len(blocks)-1)
+	for i := 0; i+1 < len(blocks); i++ {
+		scheduled = append(scheduled, verifier.verifies[blocks[i].Height])
+	}
+
+	for i := 0; i+1 < len(blocks); i++ {
+		parts, blockID, err := verifier.verify(blocks[i], blocks[i+1].LastCommit, vals.Copy())
+		require.NoError(t, err)
+		require.NotNil(t, scheduled[i])
+		assert.True(t, parts == scheduled[i].parts, "expected result of scheduled verification")
+		assert.Equal(t, blocks[i].Hash(), blockID.Hash)
+		assert.Equal(t, parts.Header(), blockID.PartsHeader)
+	}
+	assert.Empty(t, verifier.verifies)
+}
+
+func TestCommitVerifierDetectsInvalidCommits(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	otherVals, _ := types.RandValidatorSet(4, 10)
+	blocks := makeVerifierTestChain(t, 2, vals, privVals)
+
+	verifier := newCommitVerifier(verifierTestChainID, 2)
+	defer verifier.stop()
+
+	// a commit for a different block
+	verifier.schedule(blocks[0], blocks[2].LastCommit, vals)
+	_, _, err := verifier.verify(blocks[0], blocks[2].LastCommit, vals)
+	assert.Error(t, err)
+
+	// a verification scheduled with the wrong validator set isn't reused
+	verifier.schedule(blocks[1], blocks[2].LastCommit, otherVals)
+	_, _, err = verifier.verify(blocks[1], blocks[2].LastCommit, vals)
+	assert.NoError(t, err)
+	_, _, err = verifier.verify(blocks[1], blocks[2].LastCommit, otherVals)
+	assert.Error(t, err)
+}
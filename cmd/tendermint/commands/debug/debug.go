@@ -0,0 +1,47 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	nodeRPCAddr string
+	profAddr    string
+	frequency   uint
+
+	flagNodeRPCAddr = "rpc-laddr"
+	flagProfAddr    = "pprof-laddr"
+	flagFrequency   = "frequency"
+
+	logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+)
+
+// DebugCmd defines the root command containing subcommands that assist in
+// debugging running Tendermint processes.
+var DebugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "A utility to kill or watch a Tendermint process while aggregating debugging data",
+}
+
+func init() {
+	DebugCmd.PersistentFlags().SortFlags = true
+	DebugCmd.PersistentFlags().StringVar(
+		&nodeRPCAddr,
+		flagNodeRPCAddr,
+		"tcp://localhost:26657",
+		"the Tendermint node's RPC address (<host>:<port>)",
+	)
+	DebugCmd.PersistentFlags().StringVar(
+		&profAddr,
+		flagProfAddr,
+		"",
+		"the profiling server address (<host>:<port>), as set by prof_laddr; profiles are skipped if empty",
+	)
+
+	DebugCmd.AddCommand(killCmd)
+	DebugCmd.AddCommand(dumpCmd)
+}
@@ -0,0 +1,95 @@
+package debug
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/cli"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+var dumpCmd = &cobra.Command{
+	Use:   "dump [output-directory]",
+	Short: "Continuously poll a Tendermint process and dump debugging data into a single location",
+	Long: `Continuously poll a Tendermint process and dump debugging data into a single
+location at a specified frequency. At each frequency interval, an archived and compressed
+file will contain node debugging information including the goroutine and heap profiles
+if enabled.`,
+	Args: cobra.ExactArgs(1),
+	RunE: dumpCmdHandler,
+}
+
+func init() {
+	dumpCmd.Flags().UintVar(
+		&frequency,
+		flagFrequency,
+		30,
+		"the frequency (seconds) in which to poll, aggregate and dump Tendermint debug data",
+	)
+}
+
+func dumpCmdHandler(_ *cobra.Command, args []string) error {
+	outDir := args[0]
+	if outDir == "" {
+		return errors.New("invalid output directory")
+	}
+
+	if frequency == 0 {
+		return errors.New("frequency must be positive")
+	}
+
+	if _, err := os.Stat(outDir); os.IsNotExist(err) {
+		if err := os.Mkdir(outDir, os.ModePerm); err != nil {
+			return errors.Wrap(err, "failed to create output directory")
+		}
+	}
+
+	rpc := rpcclient.NewHTTP(nodeRPCAddr, "/websocket")
+
+	home := viper.GetString(cli.HomeFlag)
+	conf := cfg.DefaultConfig()
+	conf = conf.SetRoot(home)
+	cfg.EnsureRoot(conf.RootDir)
+
+	dumpDebugData(outDir, conf, rpc)
+
+	ticker := time.NewTicker(time.Duration(frequency) * time.Second)
+	for range ticker.C {
+		dumpDebugData(outDir, conf, rpc)
+	}
+
+	return nil
+}
+
+// dumpDebugData collects the node's RPC state, WAL, config and profiles into a
+// temporary directory and archives it under outDir, named after the time the
+// collection started. Errors are logged rather than returned so that a single
+// failed poll does not stop subsequent ones.
+func dumpDebugData(outDir string, conf *cfg.Config, rpc *rpcclient.HTTP) {
+	start := time.Now().UTC()
+
+	tmpDir, err := ioutil.TempDir(outDir, "tendermint_debug_tmp")
+	if err != nil {
+		logger.Error("failed to create temporary directory", "dir", tmpDir, "err", err)
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := collectDebugData(tmpDir, conf, rpc); err != nil {
+		logger.Error("failed to collect debug data", "err", err)
+		return
+	}
+
+	outFile := filepath.Join(outDir, fmt.Sprintf("%s.zip", start.Format(time.RFC3339)))
+	if err := zipDir(tmpDir, outFile); err != nil {
+		logger.Error("failed to create and compress archive", "file", outFile, "err", err)
+	}
+}
@@ -0,0 +1,136 @@
+package debug
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	cfg "github.com/tendermint/tendermint/config"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// collectDebugData dumps the node's status, network info and consensus state,
+// copies its WAL and config and, if a profiling address is set, fetches its
+// goroutine and heap profiles, writing everything under dir.
+func collectDebugData(dir string, conf *cfg.Config, rpc *rpcclient.HTTP) error {
+	logger.Info("getting node status...")
+	if err := dumpStatus(rpc, dir, "status.json"); err != nil {
+		return err
+	}
+
+	logger.Info("getting node network info...")
+	if err := dumpNetInfo(rpc, dir, "net_info.json"); err != nil {
+		return err
+	}
+
+	logger.Info("getting node consensus state...")
+	if err := dumpConsensusState(rpc, dir, "consensus_state.json"); err != nil {
+		return err
+	}
+
+	logger.Info("copying node WAL...")
+	if err := copyWAL(conf, dir); err != nil {
+		if !os.IsNotExist(errors.Cause(err)) {
+			return err
+		}
+
+		logger.Info("node WAL does not exist; continuing...")
+	}
+
+	logger.Info("copying node configuration...")
+	if err := copyConfig(conf, dir); err != nil {
+		return err
+	}
+
+	if profAddr != "" {
+		logger.Info("getting node goroutine profile...")
+		if err := dumpProfile(dir, profAddr, "goroutine", 2); err != nil {
+			return err
+		}
+
+		logger.Info("getting node heap profile...")
+		if err := dumpProfile(dir, profAddr, "heap", 2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dumpStatus gets node status state dump from the Tendermint RPC and writes it
+// to file. It returns an error upon failure.
+func dumpStatus(rpc *rpcclient.HTTP, dir, filename string) error {
+	status, err := rpc.Status()
+	if err != nil {
+		return errors.Wrap(err, "failed to get node status")
+	}
+
+	return writeStateJSONToFile(status, dir, filename)
+}
+
+// dumpNetInfo gets network information state dump from the Tendermint RPC and
+// writes it to file. It returns an error upon failure.
+func dumpNetInfo(rpc *rpcclient.HTTP, dir, filename string) error {
+	netInfo, err := rpc.NetInfo()
+	if err != nil {
+		return errors.Wrap(err, "failed to get node network information")
+	}
+
+	return writeStateJSONToFile(netInfo, dir, filename)
+}
+
+// dumpConsensusState gets consensus state dump from the Tendermint RPC and
+// writes it to file. It returns an error upon failure.
+func dumpConsensusState(rpc *rpcclient.HTTP, dir, filename string) error {
+	consDump, err := rpc.DumpConsensusState()
+	if err != nil {
+		return errors.Wrap(err, "failed to get node consensus dump")
+	}
+
+	return writeStateJSONToFile(consDump, dir, filename)
+}
+
+// copyWAL copies the Tendermint node's WAL file. It returns an error if the
+// WAL file cannot be read or copied.
+func copyWAL(conf *cfg.Config, dir string) error {
+	walPath := conf.Consensus.WalFile()
+	walFile := filepath.Base(walPath)
+
+	return copyFile(walPath, filepath.Join(dir, walFile))
+}
+
+// copyConfig copies the Tendermint node's config file. It returns an error if
+// the config file cannot be read or copied.
+func copyConfig(conf *cfg.Config, dir string) error {
+	configPath := filepath.Join(conf.RootDir, "config", "config.toml")
+
+	return copyFile(configPath, filepath.Join(dir, filepath.Base(configPath)))
+}
+
+// dumpProfile fetches the given profile from the node's pprof server and
+// writes it to file. It returns an error upon failure.
+func dumpProfile(dir, addr, profile string, debug int) error {
+	if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+		addr = "http://" + addr
+	}
+	endpoint := fmt.Sprintf("%s/debug/pprof/%s?debug=%d", addr, profile, debug)
+
+	resp, err := http.Get(endpoint) // nolint: gosec
+	if err != nil {
+		return errors.Wrapf(err, "failed to query for %s profile", profile)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s profile response body", profile)
+	}
+
+	return ioutil.WriteFile(path.Join(dir, fmt.Sprintf("%s.out", profile)), body, os.ModePerm)
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	bc "github.com/tendermint/tendermint/blockchain"
+	cfg "github.com/tendermint/tendermint/config"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/state"
+)
+
+// RollbackStateCmd rolls back the state by one height.
+var RollbackStateCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "rollback tendermint state by one height",
+	Long: `
+A state rollback is performed to recover from an incorrect application state transition,
+when Tendermint has persisted an incorrect app hash and is thus unable to make
+progress. Rollback overwrites a state at height n with the state at height n - 1.
+The application should also roll back to height n - 1. No blocks are removed, so upon
+restarting Tendermint the transactions in block n will be re-executed against the
+application.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, hash, err := RollbackState(config)
+		if err != nil {
+			return fmt.Errorf("failed to rollback state: %v", err)
+		}
+
+		fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+		return nil
+	},
+}
+
+// RollbackState takes the state at the current height n and overwrites it with the state
+// at height n - 1. Note state here refers to tendermint state not application state.
+// Returns the latest state height and app hash alongside an error if there was one.
+func RollbackState(config *cfg.Config) (int64, []byte, error) {
+	// use the parsed config to load the block and state store
+	blockStoreDB, stateDB, err := loadBlockStoreAndStateDB(config)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		blockStoreDB.Close()
+		stateDB.Close()
+	}()
+
+	// rollback the last state
+	return state.Rollback(bc.NewBlockStore(blockStoreDB), stateDB)
+}
+
+func loadBlockStoreAndStateDB(config *cfg.Config) (dbm.DB, dbm.DB, error) {
+	dbType := dbm.DBBackendType(config.DBBackend)
+
+	if !cmn.FileExists(config.DBDir()) {
+		return nil, nil, fmt.Errorf("no database directory found at %s", config.DBDir())
+	}
+
+	blockStoreDB := dbm.NewDB("blockstore", dbType, config.DBDir())
+	stateDB := dbm.NewDB("state", dbType, config.DBDir())
+
+	return blockStoreDB, stateDB, nil
+}
@@ -11,6 +11,22 @@ import (
 	nm "github.com/tendermint/tendermint/node"
 )
 
+// HaltExitCode is the exit status of a node that stopped at the halt height
+// or time, so that process managers can tell it from a crash.
+const HaltExitCode = 3
+
+// ErrHalted is returned by the node command once the node has stopped at the
+// halt height or time. It implements cli.ExitCoder, so the executor in main
+// exits with HaltExitCode.
+var ErrHalted error = haltedError{}
+
+type haltedError struct{}
+
+func (haltedError) Error() string { return "node halted at the halt height or time" }
+
+// ExitCode implements cli.ExitCoder.
+func (haltedError) ExitCode() int { return HaltExitCode }
+
 // AddNodeFlags exposes some common configuration options on the command-line
 // These are exposed for convenience of commands embedding a tendermint node
 func AddNodeFlags(cmd *cobra.Command) {
@@ -21,7 +37,9 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().String("priv_validator_laddr", config.PrivValidatorListenAddr, "Socket address to listen on for connections from external priv_validator process")
 
 	// node flags
-	cmd.Flags().Bool("fast_sync", config.FastSync, "Fast blockchain syncing")
+	cmd.Flags().Bool("fast_sync", config.FastSyncMode, "Fast blockchain syncing")
+	cmd.Flags().Int64("halt_height", config.HaltHeight, "Stop the node after committing the block at this height (0 to disable)")
+	cmd.Flags().Int64("halt_time", config.HaltTime, "Stop the node after committing the first block at or after this UNIX time in seconds (0 to disable)")
 
 	// abci flags
 	cmd.Flags().String("proxy_app", config.ProxyApp, "Proxy app address, or one of: 'kvstore', 'persistent_kvstore', 'counter', 'counter_serial' or 'noop' for local testing.")
@@ -40,6 +58,7 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("p2p.pex", config.P2P.PexReactor, "Enable/disable Peer-Exchange")
 	cmd.Flags().Bool("p2p.seed_mode", config.P2P.SeedMode, "Enable/disable seed mode")
 	cmd.Flags().String("p2p.private_peer_ids", config.P2P.PrivatePeerIDs, "Comma-delimited private peer IDs")
+	cmd.Flags().String("p2p.unconditional_peer_ids", config.P2P.UnconditionalPeerIDs, "Comma-delimited IDs of peers exempt from the peer limits")
 
 	// consensus flags
 	cmd.Flags().Bool("consensus.create_empty_blocks", config.Consensus.CreateEmptyBlocks, "Set this to false to only produce blocks when there are txs or when the AppHash changes")
@@ -75,10 +94,13 @@ func NewRunNodeCmd(nodeProvider nm.NodeProvider) *cobra.Command {
 			}
 			logger.Info("Started node", "nodeInfo", n.Switch().NodeInfo())
 
-			// Run forever
-			select {}
-
-			return nil
+			// Run until the halt height or time
+			<-n.Halted()
+			logger.Info("Reached halt height or time, exiting...")
+			if n.IsRunning() {
+				n.Stop()
+			}
+			return ErrHalted
 		},
 	}
 
@@ -36,3 +36,32 @@ func TestConfigValidateBasic(t *testing.T) {
 	cfg.Consensus.TimeoutPropose = -10 * time.Second
 	assert.Error(t, cfg.ValidateBasic())
 }
+
+func TestBaseConfigHalt(t *testing.T) {
+	cfg := DefaultBaseConfig()
+	assert.True(t, cfg.HaltAt().IsZero())
+
+	cfg.HaltHeight = 10
+	cfg.HaltTime = 1500000000
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, time.Unix(1500000000, 0), cfg.HaltAt())
+
+	cfg.HaltHeight = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.HaltHeight = 0
+	cfg.HaltTime = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestFastSyncConfigValidateBasic(t *testing.T) {
+	cfg := TestFastSyncConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// tamper with version
+	cfg.Version = "v1"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Version = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
+}
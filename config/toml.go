@@ -75,7 +75,7 @@ moniker = "{{ .BaseConfig.Moniker }}"
 # If this node is many blocks behind the tip of the chain, FastSync
 # allows them to catchup quickly by downloading blocks in parallel
 # and verifying their commits
-fast_sync = {{ .BaseConfig.FastSync }}
+fast_sync = {{ .BaseConfig.FastSyncMode }}
 
 # Database backend: leveldb | memdb | cleveldb
 db_backend = "{{ .BaseConfig.DBBackend }}"
@@ -117,6 +117,14 @@ prof_laddr = "{{ .BaseConfig.ProfListenAddress }}"
 # so the app can decide if we should keep the connection or not
 filter_peers = {{ .BaseConfig.FilterPeers }}
 
+# Stop the node after committing the block at this height,
+# eg. for a coordinated upgrade. 0 disables it.
+halt_height = {{ .BaseConfig.HaltHeight }}
+
+# Stop the node after committing the first block whose time is at or
+# after this UNIX time, in seconds. 0 disables it.
+halt_time = {{ .BaseConfig.HaltTime }}
+
 ##### advanced configuration options #####
 
 ##### rpc server configuration options #####
@@ -152,6 +160,10 @@ grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
 # Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
 unsafe = {{ .RPC.Unsafe }}
 
+# Activate the /profile RPC command, which captures a time-bounded CPU, heap,
+# goroutine, mutex or block profile and returns it in the response
+profiling = {{ .RPC.Profiling }}
+
 # Maximum number of simultaneous connections (including WebSocket).
 # Does not include gRPC connections. See grpc_max_open_connections
 # If you want to accept a larger number than the default, make sure
@@ -165,6 +177,9 @@ max_open_connections = {{ .RPC.MaxOpenConnections }}
 [p2p]
 
 # Address to listen for incoming connections
+# Use a ws:// address to accept connections over WebSocket instead of TCP
+# or a quic:// address to accept connections over QUIC (needs a build with
+# the quic tag)
 laddr = "{{ .P2P.ListenAddress }}"
 
 # Address to advertise to peers for them to dial
@@ -173,6 +188,11 @@ laddr = "{{ .P2P.ListenAddress }}"
 # to figure out the address.
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# If true and external_address is empty, learn the address to advertise from
+# the IP outbound peers see us on, once peers from a majority of distinct
+# networks agree on it
+discover_external_address = {{ .P2P.DiscoverExternalAddress }}
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
@@ -219,6 +239,10 @@ seed_mode = {{ .P2P.SeedMode }}
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
+# Comma separated list of peer IDs which are always allowed to connect,
+# regardless of the limits on the number of peers, and never evicted
+unconditional_peer_ids = "{{ .P2P.UnconditionalPeerIDs }}"
+
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
@@ -239,11 +263,20 @@ size = {{ .Mempool.Size }}
 # size of the cache (used to filter transactions we saw earlier)
 cache_size = {{ .Mempool.CacheSize }}
 
+##### fast sync configuration options #####
+[fastsync]
+
+# Fast Sync version to use:
+#   1) "v0" (default) - the legacy fast sync implementation
+#   2) "v1" - refactor of v0 as a state machine with peer scoring
+version = "{{ .FastSync.Version }}"
+
 ##### consensus configuration options #####
 [consensus]
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# Timeouts set in the consensus params (genesis or app) take precedence over these
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 timeout_propose_delta = "{{ .Consensus.TimeoutProposeDelta }}"
 timeout_prevote = "{{ .Consensus.TimeoutPrevote }}"
@@ -0,0 +1,260 @@
+// +build byzantine
+
+package consensus
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ParseMisbehaviors parses a comma-separated list of height=misbehavior
+// pairs, eg. "10=double-prevote,12=amnesia". Height 0 stands for every
+// height without its own misbehaviour.
+func ParseMisbehaviors(s string) (map[int64]Misbehavior, error) {
+	misbehaviors := make(map[int64]Misbehavior)
+	if s == "" {
+		return misbehaviors, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid misbehavior %q, expected height=misbehavior", pair)
+		}
+		height, err := strconv.ParseInt(kv[0], 10, 64)
+		if err != nil || height < 0 {
+			return nil, fmt.Errorf("invalid misbehavior height %q", kv[0])
+		}
+		m := Misbehavior(kv[1])
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		misbehaviors[height] = m
+	}
+	return misbehaviors, nil
+}
+
+// ByzantineNetwork sends messages from a byzantine validator straight to its
+// peers, as the consensus reactor only gossips what its ConsensusState
+// agreed to.
+type ByzantineNetwork interface {
+	// Peers returns the IDs of the connected peers, sorted.
+	Peers() []p2p.ID
+
+	// Send sends the message to the peer.
+	Send(peer p2p.ID, msg ConsensusMessage)
+}
+
+// ByzantineValidator makes the validator of a ConsensusState misbehave, for
+// testing. It replaces the proposal and vote steps of the ConsensusState at
+// the heights of its misbehaviours, and signs with a PrivValidator which
+// doesn't refuse to sign conflicting messages, eg. a MockPV. The
+// ConsensusState must not be started yet.
+type ByzantineValidator struct {
+	cs           *ConsensusState
+	privVal      types.PrivValidator
+	net          ByzantineNetwork
+	misbehaviors map[int64]Misbehavior
+}
+
+// NewByzantineValidator makes the validator of the ConsensusState misbehave
+// at the given heights (0 for every height), sending the byzantine messages
+// over the given network.
+func NewByzantineValidator(
+	cs *ConsensusState,
+	privVal types.PrivValidator,
+	net ByzantineNetwork,
+	misbehaviors map[int64]Misbehavior,
+) (*ByzantineValidator, error) {
+	for _, m := range misbehaviors {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	bv := &ByzantineValidator{
+		cs:           cs,
+		privVal:      privVal,
+		net:          net,
+		misbehaviors: misbehaviors,
+	}
+	cs.SetPrivValidator(privVal)
+	cs.decideProposal = bv.decideProposal
+	cs.doPrevote = bv.doPrevote
+	cs.doPrecommit = bv.doPrecommit
+	return bv, nil
+}
+
+// Misbehavior returns the misbehaviour of the validator at the height, if any.
+func (bv *ByzantineValidator) Misbehavior(height int64) (Misbehavior, bool) {
+	if m, ok := bv.misbehaviors[height]; ok {
+		return m, true
+	}
+	m, ok := bv.misbehaviors[0]
+	return m, ok
+}
+
+func (bv *ByzantineValidator) decideProposal(height int64, round int) {
+	cs := bv.cs
+	m, _ := bv.Misbehavior(height)
+	switch m {
+	case MisbehaviorEquivocatingProposal:
+		block1, parts1 := cs.createProposalBlock()
+		if block1 == nil {
+			return
+		}
+		// the same block with another tx
+		txs := append(append(types.Txs{}, block1.Txs...),
+			types.Tx(fmt.Sprintf("equivocation/%d/%d", height, round)))
+		block2, parts2 := cs.state.MakeBlock(height, txs, block1.LastCommit, block1.Evidence.Evidence,
+			block1.ProposerAddress, block1.Time)
+
+		peers := bv.net.Peers()
+		half := (len(peers) + 1) / 2
+		bv.sendProposal(peers[:half], height, round, block1, parts1, false)
+		bv.sendProposal(peers[half:], height, round, block2, parts2, false)
+		cs.Logger.Info("Misbehaving: sent equivocating proposals", "height", height, "round", round,
+			"block1", block1.Hash(), "block2", block2.Hash())
+
+	case MisbehaviorInvalidBlockParts:
+		block, parts := cs.createProposalBlock()
+		if block == nil {
+			return
+		}
+		bv.sendProposal(bv.net.Peers(), height, round, block, parts, true)
+		cs.Logger.Info("Misbehaving: sent invalid block parts", "height", height, "round", round)
+
+	default:
+		cs.defaultDecideProposal(height, round)
+	}
+}
+
+// sendProposal signs a proposal for the block, and sends it with the block
+// parts to the peers, optionally corrupting the parts.
+func (bv *ByzantineValidator) sendProposal(
+	peers []p2p.ID,
+	height int64,
+	round int,
+	block *types.Block,
+	parts *types.PartSet,
+	corrupt bool,
+) {
+	cs := bv.cs
+	blockID := types.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+	proposal := types.NewProposal(height, round, cs.ValidRound, blockID)
+	proposal.Timestamp = cs.now()
+	if cs.state.ConsensusParams.Timestamp.ProposerBased {
+		proposal.Timestamp = block.Time
+	}
+	if err := bv.privVal.SignProposal(cs.state.ChainID, proposal); err != nil {
+		cs.Logger.Error("Misbehaving: error signing proposal", "height", height, "round", round, "err", err)
+		return
+	}
+	for _, peer := range peers {
+		bv.net.Send(peer, &ProposalMessage{proposal})
+		for i := 0; i < parts.Total(); i++ {
+			part := parts.GetPart(i)
+			if corrupt {
+				bytes := append([]byte{}, part.Bytes...)
+				bytes[0] ^= 0xff
+				part = &types.Part{Index: part.Index, Bytes: bytes, Proof: part.Proof}
+			}
+			bv.net.Send(peer, &BlockPartMessage{height, round, part})
+		}
+	}
+}
+
+func (bv *ByzantineValidator) doPrevote(height int64, round int) {
+	cs := bv.cs
+	m, _ := bv.Misbehavior(height)
+	switch m {
+	case MisbehaviorDoublePrevote:
+		var vote *types.Vote
+		if cs.ProposalBlock != nil {
+			vote = cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
+		} else {
+			vote = cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		}
+		if vote == nil {
+			return
+		}
+		var hash []byte
+		var header types.PartSetHeader
+		if vote.BlockID.IsZero() {
+			hash = tmhash.Sum([]byte(fmt.Sprintf("conflicting/%d/%d", height, round)))
+			header = types.PartSetHeader{Total: 1, Hash: hash}
+		}
+		conflicting, err := cs.signVote(types.PrevoteType, hash, header)
+		if err != nil {
+			cs.Logger.Error("Misbehaving: error signing vote", "height", height, "round", round, "err", err)
+			return
+		}
+		for _, peer := range bv.net.Peers() {
+			bv.net.Send(peer, &VoteMessage{conflicting})
+		}
+		cs.Logger.Info("Misbehaving: sent conflicting prevotes", "height", height, "round", round,
+			"vote", vote, "conflicting", conflicting)
+
+	case MisbehaviorAmnesia:
+		if cs.LockedBlock != nil {
+			cs.Logger.Info("Misbehaving: forgetting locked block", "height", height, "round", round,
+				"lockedRound", cs.LockedRound, "lockedBlock", cs.LockedBlock.Hash())
+			cs.LockedRound = -1
+			cs.LockedBlock = nil
+			cs.LockedBlockParts = nil
+		}
+		cs.defaultDoPrevote(height, round)
+
+	case MisbehaviorWithholdVotes:
+		cs.Logger.Info("Misbehaving: withholding prevote", "height", height, "round", round)
+
+	default:
+		cs.defaultDoPrevote(height, round)
+	}
+}
+
+func (bv *ByzantineValidator) doPrecommit(hash []byte, header types.PartSetHeader) {
+	cs := bv.cs
+	if m, _ := bv.Misbehavior(cs.Height); m == MisbehaviorWithholdVotes {
+		cs.Logger.Info("Misbehaving: withholding precommit", "height", cs.Height, "round", cs.Round)
+		return
+	}
+	cs.defaultDoPrecommit(hash, header)
+}
+
+//-----------------------------------------------------------------------------
+
+// ByzantineNetwork returns a network sending messages straight to the peers
+// of the reactor's switch.
+func (conR *ConsensusReactor) ByzantineNetwork() ByzantineNetwork {
+	return reactorNetwork{conR}
+}
+
+type reactorNetwork struct {
+	conR *ConsensusReactor
+}
+
+func (net reactorNetwork) Peers() []p2p.ID {
+	var ids []p2p.ID
+	for _, peer := range net.conR.Switch.Peers().List() {
+		ids = append(ids, peer.ID())
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (net reactorNetwork) Send(id p2p.ID, msg ConsensusMessage) {
+	peer := net.conR.Switch.Peers().Get(id)
+	if peer == nil {
+		return
+	}
+	chID := DataChannel
+	if _, ok := msg.(*VoteMessage); ok {
+		chID = VoteChannel
+	}
+	peer.Send(chID, cdc.MustMarshalBinaryBare(msg))
+}
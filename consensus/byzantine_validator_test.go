@@ -0,0 +1,239 @@
+// +build byzantine
+
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestParseMisbehaviors(t *testing.T) {
+	misbehaviors, err := ParseMisbehaviors("10=double-prevote, 0=amnesia")
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]Misbehavior{
+		10: MisbehaviorDoublePrevote,
+		0:  MisbehaviorAmnesia,
+	}, misbehaviors)
+
+	misbehaviors, err = ParseMisbehaviors("")
+	require.NoError(t, err)
+	assert.Empty(t, misbehaviors)
+
+	for _, s := range []string{"double-prevote", "x=amnesia", "-1=amnesia", "10=lying"} {
+		_, err := ParseMisbehaviors(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestByzantineValidatorMisbehavior(t *testing.T) {
+	bv := &ByzantineValidator{misbehaviors: map[int64]Misbehavior{
+		0: MisbehaviorAmnesia,
+		3: MisbehaviorDoublePrevote,
+	}}
+	m, ok := bv.Misbehavior(3)
+	assert.True(t, ok)
+	assert.Equal(t, MisbehaviorDoublePrevote, m)
+	m, ok = bv.Misbehavior(4)
+	assert.True(t, ok)
+	assert.Equal(t, MisbehaviorAmnesia, m)
+
+	bv = &ByzantineValidator{misbehaviors: map[int64]Misbehavior{3: MisbehaviorDoublePrevote}}
+	_, ok = bv.Misbehavior(4)
+	assert.False(t, ok)
+}
+
+// Runs 4 validators, of which the first one misbehaves at every height, and
+// checks that the others keep committing the same blocks. The messages sent
+// by the first validator are passed to the checks.
+func TestByzantineValidatorSimulation(t *testing.T) {
+	testCases := []struct {
+		misbehavior Misbehavior
+		rule        SimRule
+		check       func(t *testing.T, sim *Simulation, sent []SimMessage)
+	}{
+		{MisbehaviorDoublePrevote, nil, func(t *testing.T, sim *Simulation, sent []SimMessage) {
+			address := sim.PrivValidator(0).GetPubKey().Address()
+			assertEvidence(t, address, sim.Evidence(1))
+			for i := 1; i < 4; i++ {
+				assertEvidence(t, address, sim.CommittedEvidence(i))
+			}
+		}},
+		{MisbehaviorEquivocatingProposal, nil, func(t *testing.T, sim *Simulation, sent []SimMessage) {
+			// different peers were sent proposals for different blocks in
+			// the same round
+			blocks := make(map[string]map[string]bool)
+			for _, m := range sent {
+				if pm, ok := m.Msg.(*ProposalMessage); ok {
+					hr := fmt.Sprintf("%d/%d", pm.Proposal.Height, pm.Proposal.Round)
+					if blocks[hr] == nil {
+						blocks[hr] = make(map[string]bool)
+					}
+					blocks[hr][string(pm.Proposal.BlockID.Hash)] = true
+				}
+			}
+			equivocations := 0
+			for _, hashes := range blocks {
+				if len(hashes) > 1 {
+					equivocations++
+				}
+			}
+			assert.NotZero(t, equivocations, "no conflicting proposals")
+		}},
+		{MisbehaviorAmnesia, lockOnlyNode0(2), func(t *testing.T, sim *Simulation, sent []SimMessage) {
+			// it precommitted a block, and then prevoted another one at the
+			// same height
+			address := sim.PrivValidator(0).GetPubKey().Address()
+			votes := ownVotes(address, sent)
+			found := false
+			for _, precommit := range votes {
+				if precommit.Type != types.PrecommitType || precommit.BlockID.IsZero() {
+					continue
+				}
+				for _, prevote := range votes {
+					if prevote.Type == types.PrevoteType && prevote.Height == precommit.Height &&
+						prevote.Round > precommit.Round && !prevote.BlockID.IsZero() &&
+						!prevote.BlockID.Equals(precommit.BlockID) {
+						found = true
+					}
+				}
+			}
+			assert.True(t, found, "no prevote for another block than the locked one")
+		}},
+		{MisbehaviorWithholdVotes, nil, func(t *testing.T, sim *Simulation, sent []SimMessage) {
+			// it never voted, so its precommits aren't in any commit
+			address := sim.PrivValidator(0).GetPubKey().Address()
+			assert.Empty(t, ownVotes(address, sent))
+			store := sim.BlockStore(1)
+			for height := int64(1); height <= store.Height(); height++ {
+				for _, vote := range store.LoadSeenCommit(height).Precommits {
+					if vote != nil {
+						assert.NotEqual(t, address, vote.ValidatorAddress, "height %d", height)
+					}
+				}
+			}
+		}},
+		{MisbehaviorInvalidBlockParts, nil, func(t *testing.T, sim *Simulation, sent []SimMessage) {
+			// the peers never get the blocks it proposes
+			address := sim.PrivValidator(0).GetPubKey().Address()
+			store := sim.BlockStore(1)
+			for height := int64(1); height <= store.Height(); height++ {
+				assert.NotEqual(t, address, store.LoadBlock(height).ProposerAddress, "height %d", height)
+			}
+		}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(string(tc.misbehavior), func(t *testing.T) {
+			sim := newTestSimulation(t, SimConfig{
+				Validators: 4,
+				Seed:       3,
+				MinLatency: 5 * time.Millisecond,
+				MaxLatency: 50 * time.Millisecond,
+			})
+			defer sim.Stop()
+			_, err := NewByzantineValidator(sim.Node(0), sim.PrivValidator(0), sim.ByzantineNetwork(0),
+				map[int64]Misbehavior{0: tc.misbehavior})
+			require.NoError(t, err)
+
+			var sent []SimMessage
+			sim.AddRule(func(sim *Simulation, m SimMessage) (bool, time.Duration) {
+				if m.From == 0 {
+					sent = append(sent, m)
+				}
+				return false, 0
+			})
+			if tc.rule != nil {
+				sim.AddRule(tc.rule)
+			}
+
+			require.NoError(t, sim.RunUntilHeight(8, 5*time.Minute))
+			tc.check(t, sim, sent)
+		})
+	}
+}
+
+// lockOnlyNode0 makes only the first node lock on the block proposed in the
+// first round at the given height. The fourth node doesn't get the proposal,
+// so it prevotes nil, and each of the other nodes is kept from the prevote of
+// one of the second and third nodes. The others then precommit nil, and
+// commit another block in a later round.
+func lockOnlyNode0(height int64) SimRule {
+	// the validator whose prevote is withheld from each node
+	withheld := map[int]int{1: 2, 2: 1, 3: 2}
+	return func(sim *Simulation, m SimMessage) (bool, time.Duration) {
+		switch msg := m.Msg.(type) {
+		case *ProposalMessage:
+			return m.To == 3 && msg.Proposal.Height == height && msg.Proposal.Round == 0, 0
+		case *VoteMessage:
+			vote := msg.Vote
+			if m.To == 0 || vote.Type != types.PrevoteType || vote.Height != height || vote.Round != 0 {
+				return false, 0
+			}
+			address := sim.PrivValidator(withheld[m.To]).GetPubKey().Address()
+			return bytes.Equal(address, vote.ValidatorAddress), 0
+		}
+		return false, 0
+	}
+}
+
+// ownVotes returns the votes of the validator in the messages.
+func ownVotes(address []byte, msgs []SimMessage) []*types.Vote {
+	var votes []*types.Vote
+	for _, m := range msgs {
+		if vm, ok := m.Msg.(*VoteMessage); ok && bytes.Equal(address, vm.Vote.ValidatorAddress) {
+			votes = append(votes, vm.Vote)
+		}
+	}
+	return votes
+}
+
+func assertEvidence(t *testing.T, address []byte, evidence []types.Evidence) {
+	for _, ev := range evidence {
+		if _, ok := ev.(*types.DuplicateVoteEvidence); ok && bytes.Equal(address, ev.Address()) {
+			return
+		}
+	}
+	t.Errorf("no duplicate vote evidence against %X in %v", address, evidence)
+}
+
+//-----------------------------------------------------------------------------
+
+// ByzantineNetwork returns a network sending messages from the i-th node to
+// the others, subject to the rules.
+func (sim *Simulation) ByzantineNetwork(i int) ByzantineNetwork {
+	return simNetwork{sim, i}
+}
+
+// simNetwork is the ByzantineNetwork of a node of a Simulation.
+type simNetwork struct {
+	sim  *Simulation
+	node int
+}
+
+func (net simNetwork) Peers() []p2p.ID {
+	var ids []p2p.ID
+	for i := range net.sim.nodes {
+		if i != net.node {
+			ids = append(ids, simPeerID(i))
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (net simNetwork) Send(peer p2p.ID, msg ConsensusMessage) {
+	for i := range net.sim.nodes {
+		if simPeerID(i) == peer {
+			net.sim.send(SimMessage{From: net.node, To: i, Msg: msg})
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package consensus
+
+import (
+	"fmt"
+)
+
+// Misbehavior is a byzantine behaviour of a validator, for testing. The
+// ByzantineValidator implementing them is only built with the byzantine
+// build tag.
+type Misbehavior string
+
+const (
+	// MisbehaviorDoublePrevote prevotes as usual, and sends the peers a
+	// conflicting prevote: for nil if it prevoted a block, or for a made up
+	// block otherwise.
+	MisbehaviorDoublePrevote Misbehavior = "double-prevote"
+
+	// MisbehaviorEquivocatingProposal proposes two different blocks, each to
+	// half of the peers.
+	MisbehaviorEquivocatingProposal Misbehavior = "equivocating-proposal"
+
+	// MisbehaviorAmnesia forgets the block it is locked on before prevoting,
+	// so it prevotes for the proposal block of a later round.
+	MisbehaviorAmnesia Misbehavior = "amnesia"
+
+	// MisbehaviorWithholdVotes neither prevotes nor precommits.
+	MisbehaviorWithholdVotes Misbehavior = "withhold-votes"
+
+	// MisbehaviorInvalidBlockParts proposes a block, but sends the peers
+	// block parts which don't match the proposal.
+	MisbehaviorInvalidBlockParts Misbehavior = "invalid-block-parts"
+)
+
+// Misbehaviors lists the supported misbehaviours.
+var Misbehaviors = []Misbehavior{
+	MisbehaviorDoublePrevote,
+	MisbehaviorEquivocatingProposal,
+	MisbehaviorAmnesia,
+	MisbehaviorWithholdVotes,
+	MisbehaviorInvalidBlockParts,
+}
+
+// ValidateBasic returns an error if the misbehaviour is unknown.
+func (m Misbehavior) ValidateBasic() error {
+	for _, known := range Misbehaviors {
+		if m == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown misbehavior %q", string(m))
+}
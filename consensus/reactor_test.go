@@ -35,6 +35,25 @@ func init() {
 // in-process testnets
 
 func startConsensusNet(t *testing.T, css []*ConsensusState, N int) ([]*ConsensusReactor, []chan interface{}, []*types.EventBus) {
+	return startConsensusNetWithSwitches(t, css, N, func(initSwitch func(int, *p2p.Switch) *p2p.Switch) {
+		p2p.MakeConnectedSwitches(config.P2P, N, initSwitch, p2p.Connect2Switches)
+	})
+}
+
+// startConsensusSimNet is like startConsensusNet, but connects the reactors
+// over the given simulated network.
+func startConsensusSimNet(t *testing.T, css []*ConsensusState, N int, network *p2p.SimNetwork) ([]*ConsensusReactor, []chan interface{}, []*types.EventBus) {
+	return startConsensusNetWithSwitches(t, css, N, func(initSwitch func(int, *p2p.Switch) *p2p.Switch) {
+		p2p.MakeSimSwitches(config.P2P, network, N, initSwitch, p2p.ConnectSimSwitches)
+	})
+}
+
+func startConsensusNetWithSwitches(
+	t *testing.T,
+	css []*ConsensusState,
+	N int,
+	makeSwitches func(initSwitch func(int, *p2p.Switch) *p2p.Switch),
+) ([]*ConsensusReactor, []chan interface{}, []*types.EventBus) {
 	reactors := make([]*ConsensusReactor, N)
 	eventChans := make([]chan interface{}, N)
 	eventBuses := make([]*types.EventBus, N)
@@ -53,11 +72,11 @@ func startConsensusNet(t *testing.T, css []*ConsensusState, N int) ([]*Consensus
 		require.NoError(t, err)
 	}
 	// make connected switches and start all reactors
-	p2p.MakeConnectedSwitches(config.P2P, N, func(i int, s *p2p.Switch) *p2p.Switch {
+	makeSwitches(func(i int, s *p2p.Switch) *p2p.Switch {
 		s.AddReactor("CONSENSUS", reactors[i])
 		s.SetLogger(reactors[i].conS.Logger.With("module", "p2p"))
 		return s
-	}, p2p.Connect2Switches)
+	})
 
 	// now that everyone is connected,  start the state machines
 	// If we started the state machines before everyone was connected,
@@ -95,6 +114,60 @@ func TestReactorBasic(t *testing.T) {
 	}, css)
 }
 
+// Ensure the validators on the majority side of a partition keep making
+// blocks, and the isolated one catches up once the partition heals
+func TestReactorPartitionedValidatorCatchesUp(t *testing.T) {
+	N := 4
+	css := randConsensusNet(N, "consensus_reactor_test", NewTimeoutTicker, newCounter)
+	network := p2p.NewSimNetwork(p2p.SimNetworkSeed(1))
+	defer network.Close()
+	network.SetDefaultLink(p2p.SimLink{Latency: 5 * time.Millisecond, Jitter: 5 * time.Millisecond})
+
+	// isolate the last validator, the others still have 3/4 of the voting
+	// power
+	reactors, eventChans, eventBuses := startConsensusSimNet(t, css, N, network)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	network.Partition(
+		p2p.SwitchIPs(reactors[0].Switch, reactors[1].Switch, reactors[2].Switch),
+		p2p.SwitchIPs(reactors[N-1].Switch),
+	)
+
+	for i := 0; i < 2; i++ {
+		timeoutWaitGroup(t, N-1, func(j int) {
+			<-eventChans[j]
+		}, css)
+	}
+	height := css[0].GetState().LastBlockHeight
+	require.True(t, css[N-1].GetState().LastBlockHeight < height)
+
+	// keep the others from blocking on their event channels meanwhile
+	done := make(chan struct{})
+	defer close(done)
+	for j := 0; j < N-1; j++ {
+		go func(j int) {
+			for {
+				select {
+				case <-eventChans[j]:
+				case <-done:
+					return
+				}
+			}
+		}(j)
+	}
+
+	network.Heal()
+	for {
+		select {
+		case newBlockI := <-eventChans[N-1]:
+			if newBlockI.(types.EventDataNewBlock).Block.Height >= height {
+				return
+			}
+		case <-time.After(20 * time.Second):
+			t.Fatal("isolated validator did not catch up after the partition healed")
+		}
+	}
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	types.RegisterMockEvidences(cdc)
@@ -147,7 +220,7 @@ func TestReactorWithEvidence(t *testing.T) {
 		evpool := newMockEvidencePool(addr)
 
 		// Make ConsensusState
-		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
+		blockExec := sm.NewBlockExecutor(stateDB, log.TestingLogger(), proxyAppConnCon, mempool, evpool, blockStore)
 		cs := NewConsensusState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
 		cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 		cs.SetPrivValidator(pv)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cstypes "github.com/tendermint/tendermint/consensus/types"
 	//auto "github.com/tendermint/tendermint/libs/autofile"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -86,6 +87,14 @@ func (cs *ConsensusState) readReplayMessage(msg *TimedWALMessage, newStepCh chan
 	case timeoutInfo:
 		cs.Logger.Info("Replay: Timeout", "height", m.Height, "round", m.Round, "step", m.Step, "dur", m.Duration)
 		cs.handleTimeout(m, cs.RoundState)
+	case proposalTimeInfo:
+		cs.Logger.Info("Replay: Proposal time", "height", m.Height, "round", m.Round, "time", m.Time)
+		cs.proposalTime = m
+		// We may have crashed before our proposal made it to the WAL.
+		// The proposal made when replaying the propose step used another time.
+		if cs.Height == m.Height && cs.Round == m.Round && cs.Step == cstypes.RoundStepPropose && cs.Proposal == nil {
+			cs.decideProposal(m.Height, m.Round)
+		}
 	default:
 		return fmt.Errorf("Replay: Unknown TimedWALMessage type: %v", reflect.TypeOf(msg.Msg))
 	}
@@ -431,7 +440,8 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 	block := h.store.LoadBlock(height)
 	meta := h.store.LoadBlockMeta(height)
 
-	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, sm.MockMempool{}, sm.MockEvidencePool{})
+	blockExec := sm.NewBlockExecutor(h.stateDB, h.logger, proxyApp, sm.MockMempool{}, sm.MockEvidencePool{},
+		h.store)
 
 	var err error
 	state, err = blockExec.ApplyBlock(state, meta.BlockID, block)
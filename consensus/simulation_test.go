@@ -0,0 +1,757 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
+	bc "github.com/tendermint/tendermint/blockchain"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/evidence"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+// simGenesisTime is the genesis time of simulated chains, at which the
+// virtual clock starts.
+var simGenesisTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SimConfig describes a Simulation.
+type SimConfig struct {
+	// Number of validators, each with the same voting power.
+	Validators int
+
+	// Seed of the simulation's random source, which also derives the
+	// validators' keys. A simulation replays identically with the same seed,
+	// config and script. 0 picks a seed from the wall clock (see
+	// Simulation.Seed).
+	Seed int64
+
+	// The latency of each message is picked uniformly within these bounds,
+	// so messages can be delivered out of order.
+	MinLatency time.Duration
+	MaxLatency time.Duration
+
+	// Nodes resend the proposal and votes of their round to the others at
+	// this interval, and send the blocks and commits they miss to the ones
+	// that fell behind, like the reactor's gossip routines. Defaults to 1s.
+	GossipInterval time.Duration
+
+	// Config of every node. Defaults to DefaultConsensusConfig. Nodes have
+	// an empty mempool, so it must create empty blocks.
+	Consensus *cfg.ConsensusConfig
+
+	// NewApp returns the app of the i-th node. Defaults to a kvstore.
+	NewApp func(i int) abci.Application
+
+	// Defaults to a nop logger.
+	Logger log.Logger
+}
+
+// SimMessage is a consensus message sent from one node to another.
+type SimMessage struct {
+	From int
+	To   int
+	Msg  ConsensusMessage
+}
+
+// SimRule is consulted for every message sent between nodes of a Simulation,
+// in the order the rules were added. It returns whether to drop the message,
+// or how long to delay it on top of its latency. Rules must only draw random
+// numbers from Simulation.Rand for the simulation to be replayable.
+type SimRule func(sim *Simulation, msg SimMessage) (drop bool, delay time.Duration)
+
+// SimDropRate drops messages at random with the given probability.
+func SimDropRate(rate float64) SimRule {
+	return func(sim *Simulation, msg SimMessage) (bool, time.Duration) {
+		return sim.Rand().Float64() < rate, 0
+	}
+}
+
+// SimPartition drops the messages between nodes of different groups from
+// the start to the end of the given period, counted from the start of the
+// simulation. Nodes in no group are partitioned from every other node.
+func SimPartition(start, end time.Duration, groups ...[]int) SimRule {
+	group := make(map[int]int)
+	for g, nodes := range groups {
+		for _, node := range nodes {
+			group[node] = g + 1
+		}
+	}
+	return func(sim *Simulation, msg SimMessage) (bool, time.Duration) {
+		if elapsed := sim.Elapsed(); elapsed < start || elapsed >= end {
+			return false, 0
+		}
+		gFrom, gTo := group[msg.From], group[msg.To]
+		return gFrom == 0 || gFrom != gTo, 0
+	}
+}
+
+// Simulation runs the consensus of a network of validators deterministically.
+// Their ConsensusStates are driven from a single goroutine, with a virtual
+// clock in place of their TimeoutTicker and of the wall clock. Messages are
+// delivered by a scheduler, which can be scripted to delay, drop or reorder
+// them (see SimRule), and to inject arbitrary messages, eg. from a byzantine
+// validator (see SetOutbound and Inject).
+//
+// The simulation checks that the nodes never commit different blocks at the
+// same height.
+type Simulation struct {
+	config SimConfig
+	seed   int64
+	rng    *rand.Rand
+
+	start time.Time
+	now   time.Time
+
+	nodes []*simNode
+	rules []SimRule
+
+	queue simQueue
+	seq   uint64
+
+	committed map[int64][]byte // block hash committed at each height
+	err       error            // safety violation
+}
+
+type simNode struct {
+	cs         *ConsensusState
+	privVal    *types.MockPV
+	ticker     *simTicker
+	evpool     *simEvidencePool
+	blockStore *bc.BlockStore
+	eventBus   *types.EventBus
+	proxyApp   proxy.AppConns
+	outbound   func(SimMessage) []SimMessage
+	checked    int64 // last height checked for safety
+}
+
+// NewSimulation returns a Simulation of a new chain with the given config.
+// Every node starts its first round at the genesis time.
+func NewSimulation(config SimConfig) (*Simulation, error) {
+	if config.Validators < 1 {
+		return nil, errors.New("a simulation needs at least one validator")
+	}
+	if config.MaxLatency < config.MinLatency {
+		return nil, errors.New("max latency must not be less than min latency")
+	}
+	if config.Seed == 0 {
+		config.Seed = tmtime.Now().UnixNano()
+	}
+	if config.GossipInterval == 0 {
+		config.GossipInterval = time.Second
+	}
+	if config.Consensus == nil {
+		config.Consensus = cfg.DefaultConsensusConfig()
+	}
+	if config.NewApp == nil {
+		config.NewApp = func(int) abci.Application { return kvstore.NewKVStoreApplication() }
+	}
+	if config.Logger == nil {
+		config.Logger = log.NewNopLogger()
+	}
+
+	sim := &Simulation{
+		config:    config,
+		seed:      config.Seed,
+		rng:       rand.New(rand.NewSource(config.Seed)),
+		start:     simGenesisTime,
+		now:       simGenesisTime,
+		committed: make(map[int64][]byte),
+	}
+
+	privVals := make([]*types.MockPV, config.Validators)
+	genDoc := &types.GenesisDoc{
+		GenesisTime: simGenesisTime,
+		ChainID:     "sim",
+		Validators:  make([]types.GenesisValidator, config.Validators),
+	}
+	for i := range privVals {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("sim-%d-%d", config.Seed, i)))
+		privVals[i] = types.NewMockPVWithPrivKey(privKey)
+		genDoc.Validators[i] = types.GenesisValidator{PubKey: privKey.PubKey(), Power: 10}
+	}
+	state, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, privVal := range privVals {
+		node, err := sim.newNode(i, state.Copy(), privVal)
+		if err != nil {
+			sim.Stop()
+			return nil, err
+		}
+		sim.nodes = append(sim.nodes, node)
+	}
+
+	for _, node := range sim.nodes {
+		node.cs.scheduleRound0(&node.cs.RoundState)
+	}
+	sim.push(&simEvent{at: sim.now.Add(config.GossipInterval), kind: simGossip})
+	return sim, nil
+}
+
+func (sim *Simulation) newNode(i int, state sm.State, privVal *types.MockPV) (*simNode, error) {
+	logger := sim.config.Logger.With("node", i)
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(sim.config.NewApp(i)))
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	if err := proxyApp.Start(); err != nil {
+		return nil, errors.Wrap(err, "error starting proxy app connections")
+	}
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(logger.With("module", "events"))
+	if err := eventBus.Start(); err != nil {
+		proxyApp.Stop()
+		return nil, errors.Wrap(err, "error starting event bus")
+	}
+
+	stateDB := dbm.NewMemDB()
+	sm.SaveState(stateDB, state)
+	blockStore := bc.NewBlockStore(dbm.NewMemDB())
+	evpool := &simEvidencePool{
+		EvidencePool: evidence.NewEvidencePool(stateDB, blockStore, evidence.NewEvidenceStore(dbm.NewMemDB())),
+	}
+	evpool.SetLogger(logger.With("module", "evidence"))
+	blockExec := sm.NewBlockExecutor(stateDB, logger.With("module", "state"),
+		proxyApp.Consensus(), sm.MockMempool{}, evpool, blockStore)
+
+	ticker := &simTicker{sim: sim, node: i}
+	cs := NewConsensusState(sim.config.Consensus, state, blockExec, blockStore,
+		sm.MockMempool{}, evpool, stateClock(sim.Now))
+	cs.SetTimeoutTicker(ticker)
+	cs.SetLogger(logger.With("module", "consensus"))
+	cs.SetPrivValidator(privVal)
+	cs.SetEventBus(eventBus)
+
+	return &simNode{
+		cs:         cs,
+		privVal:    privVal,
+		ticker:     ticker,
+		evpool:     evpool,
+		blockStore: blockStore,
+		eventBus:   eventBus,
+		proxyApp:   proxyApp,
+	}, nil
+}
+
+// Stop stops the nodes' apps and event buses.
+func (sim *Simulation) Stop() {
+	for _, node := range sim.nodes {
+		node.eventBus.Stop()
+		node.proxyApp.Stop()
+	}
+}
+
+// Seed returns the seed to replay the simulation with.
+func (sim *Simulation) Seed() int64 {
+	return sim.seed
+}
+
+// Rand returns the simulation's random source.
+func (sim *Simulation) Rand() *rand.Rand {
+	return sim.rng
+}
+
+// Now returns the virtual time.
+func (sim *Simulation) Now() time.Time {
+	return sim.now
+}
+
+// Elapsed returns the virtual time since the start of the simulation.
+func (sim *Simulation) Elapsed() time.Duration {
+	return sim.now.Sub(sim.start)
+}
+
+// Node returns the ConsensusState of the i-th node.
+func (sim *Simulation) Node(i int) *ConsensusState {
+	return sim.nodes[i].cs
+}
+
+// PrivValidator returns the private validator of the i-th node, eg. to sign
+// conflicting votes.
+func (sim *Simulation) PrivValidator(i int) types.PrivValidator {
+	return sim.nodes[i].privVal
+}
+
+// BlockStore returns the block store of the i-th node.
+func (sim *Simulation) BlockStore(i int) sm.BlockStore {
+	return sim.nodes[i].blockStore
+}
+
+// Evidence returns the evidence of misbehaviour detected by the i-th node.
+func (sim *Simulation) Evidence(i int) []types.Evidence {
+	return sim.nodes[i].evpool.added
+}
+
+// CommittedEvidence returns the evidence in the blocks committed by the i-th
+// node.
+func (sim *Simulation) CommittedEvidence(i int) []types.Evidence {
+	var committed []types.Evidence
+	store := sim.nodes[i].blockStore
+	for height := int64(1); height <= store.Height(); height++ {
+		committed = append(committed, store.LoadBlock(height).Evidence.Evidence...)
+	}
+	return committed
+}
+
+// AddRule adds a rule applied to the messages sent from then on.
+func (sim *Simulation) AddRule(rule SimRule) {
+	sim.rules = append(sim.rules, rule)
+}
+
+// SetOutbound replaces the messages the i-th node sends to other nodes by the
+// ones returned by the given function, which can eg. drop, alter or add
+// messages. The returned messages are then subject to the rules.
+func (sim *Simulation) SetOutbound(i int, outbound func(SimMessage) []SimMessage) {
+	sim.nodes[i].outbound = outbound
+}
+
+// Inject delivers the message after the given delay, bypassing the rules.
+func (sim *Simulation) Inject(delay time.Duration, msg SimMessage) {
+	sim.push(&simEvent{at: sim.now.Add(delay), kind: simDeliver, msg: msg})
+}
+
+// RunUntil runs the simulation until the condition is true. It returns an
+// error if the virtual time advances by more than the given duration first,
+// or if the nodes commit different blocks at the same height.
+func (sim *Simulation) RunUntil(cond func() bool, maxDuration time.Duration) error {
+	deadline := sim.now.Add(maxDuration)
+	for sim.err == nil && !cond() {
+		if len(sim.queue) == 0 || sim.queue[0].at.After(deadline) {
+			return fmt.Errorf("condition not met after %v (seed %d)", maxDuration, sim.seed)
+		}
+		sim.Step()
+	}
+	return sim.err
+}
+
+// RunFor runs the simulation for the given virtual time. It returns an
+// error if the nodes commit different blocks at the same height.
+func (sim *Simulation) RunFor(duration time.Duration) error {
+	deadline := sim.now.Add(duration)
+	for sim.err == nil && len(sim.queue) > 0 && !sim.queue[0].at.After(deadline) {
+		sim.Step()
+	}
+	sim.now = deadline
+	return sim.err
+}
+
+// RunUntilHeight runs the simulation until every node committed the given
+// height. See RunUntil.
+func (sim *Simulation) RunUntilHeight(height int64, maxDuration time.Duration) error {
+	return sim.RunUntil(func() bool {
+		for _, node := range sim.nodes {
+			if node.blockStore.Height() < height {
+				return false
+			}
+		}
+		return true
+	}, maxDuration)
+}
+
+// Step processes the next event: a message delivery or a timeout. It returns
+// false if there are no events left.
+func (sim *Simulation) Step() bool {
+	if len(sim.queue) == 0 {
+		return false
+	}
+	ev := heap.Pop(&sim.queue).(*simEvent)
+	sim.now = ev.at
+
+	switch ev.kind {
+	case simTimeout:
+		node := sim.nodes[ev.node]
+		if ev.gen != node.ticker.gen {
+			return true // replaced by a later timeout
+		}
+		node.cs.handleTimeout(ev.ti, node.cs.RoundState)
+		sim.flush(ev.node)
+	case simDeliver:
+		// go through the wire format, as the reactor would
+		msg, err := decodeMsg(cdc.MustMarshalBinaryBare(ev.msg.Msg))
+		if err == nil {
+			err = msg.ValidateBasic()
+		}
+		if err != nil {
+			sim.config.Logger.Info("Dropping invalid message", "from", ev.msg.From, "to", ev.msg.To, "err", err)
+			return true
+		}
+		sim.nodes[ev.msg.To].cs.handleMsg(msgInfo{msg, simPeerID(ev.msg.From)})
+		sim.flush(ev.msg.To)
+	case simGossip:
+		sim.gossip()
+		sim.push(&simEvent{at: sim.now.Add(sim.config.GossipInterval), kind: simGossip})
+	}
+	sim.checkSafety()
+	return true
+}
+
+// flush processes the messages the node sent itself, like its receiveRoutine
+// would, and broadcasts them to the other nodes.
+func (sim *Simulation) flush(i int) {
+	cs := sim.nodes[i].cs
+	for {
+		select {
+		case mi := <-cs.internalMsgQueue:
+			cs.handleMsg(mi)
+			sim.broadcast(i, mi.Msg)
+		case <-cs.statsMsgQueue:
+			// only used by the reactor
+		default:
+			return
+		}
+	}
+}
+
+func (sim *Simulation) broadcast(from int, msg ConsensusMessage) {
+	for to := range sim.nodes {
+		if to == from {
+			continue
+		}
+		sim.sendFrom(from, to, msg)
+	}
+}
+
+// sendFrom sends the message through the node's outbound function, if any.
+func (sim *Simulation) sendFrom(from, to int, msg ConsensusMessage) {
+	m := SimMessage{From: from, To: to, Msg: msg}
+	if outbound := sim.nodes[from].outbound; outbound != nil {
+		for _, m := range outbound(m) {
+			sim.send(m)
+		}
+		return
+	}
+	sim.send(m)
+}
+
+func (sim *Simulation) send(msg SimMessage) {
+	var delay time.Duration
+	for _, rule := range sim.rules {
+		drop, d := rule(sim, msg)
+		if drop {
+			return
+		}
+		delay += d
+	}
+	latency := sim.config.MinLatency
+	if spread := sim.config.MaxLatency - sim.config.MinLatency; spread > 0 {
+		latency += time.Duration(sim.rng.Int63n(int64(spread) + 1))
+	}
+	sim.push(&simEvent{at: sim.now.Add(latency + delay), kind: simDeliver, msg: msg})
+}
+
+// gossip resends the messages that may have been dropped, or ignored by nodes
+// which were not at the same height yet. Each node is sent the proposal and
+// votes of its round by the nodes at the same height, or the precommits and
+// parts of the block it misses by the first node which committed it.
+func (sim *Simulation) gossip() {
+	for to, peer := range sim.nodes {
+		height, round := peer.cs.Height, peer.cs.Round
+		for from, node := range sim.nodes {
+			if from == to || node.blockStore.Height() < height {
+				continue
+			}
+			commit := node.blockStore.LoadSeenCommit(height)
+			for _, vote := range commit.Precommits {
+				if vote != nil {
+					sim.sendFrom(from, to, &VoteMessage{vote})
+				}
+			}
+			meta := node.blockStore.LoadBlockMeta(height)
+			for j := 0; j < meta.BlockID.PartsHeader.Total; j++ {
+				part := node.blockStore.LoadBlockPart(height, j)
+				sim.sendFrom(from, to, &BlockPartMessage{height, commit.Round(), part})
+			}
+			break
+		}
+
+		for from, node := range sim.nodes {
+			rs := &node.cs.RoundState
+			if from == to || rs.Height != height {
+				continue
+			}
+			if rs.Proposal != nil && rs.Proposal.Round == round {
+				sim.sendFrom(from, to, &ProposalMessage{rs.Proposal})
+				for j := 0; j < rs.ProposalBlockParts.Total(); j++ {
+					if part := rs.ProposalBlockParts.GetPart(j); part != nil {
+						sim.sendFrom(from, to, &BlockPartMessage{height, round, part})
+					}
+				}
+			}
+			for _, votes := range []*types.VoteSet{rs.Votes.Prevotes(round), rs.Votes.Precommits(round)} {
+				if votes == nil {
+					continue
+				}
+				for j := 0; j < votes.Size(); j++ {
+					if vote := votes.GetByIndex(j); vote != nil {
+						sim.sendFrom(from, to, &VoteMessage{vote})
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkSafety checks the blocks committed since the last check against the
+// ones committed by other nodes at the same heights.
+func (sim *Simulation) checkSafety() {
+	for i, node := range sim.nodes {
+		for ; node.checked < node.blockStore.Height(); node.checked++ {
+			height := node.checked + 1
+			hash := node.blockStore.LoadBlockMeta(height).BlockID.Hash
+			committed, ok := sim.committed[height]
+			if !ok {
+				sim.committed[height] = hash
+			} else if !bytes.Equal(committed, hash) && sim.err == nil {
+				sim.err = fmt.Errorf("node %d committed block %X at height %d, but another node committed %X (seed %d)",
+					i, hash, height, committed, sim.seed)
+			}
+		}
+	}
+}
+
+func (sim *Simulation) push(ev *simEvent) {
+	if ev.at.Before(sim.now) {
+		ev.at = sim.now
+	}
+	sim.seq++
+	ev.seq = sim.seq
+	heap.Push(&sim.queue, ev)
+}
+
+func simPeerID(i int) p2p.ID {
+	return p2p.ID(fmt.Sprintf("sim%d", i))
+}
+
+//-----------------------------------------------------------------------------
+
+type simEventKind int
+
+const (
+	simDeliver simEventKind = iota
+	simTimeout
+	simGossip
+)
+
+type simEvent struct {
+	at   time.Time
+	seq  uint64 // orders the events scheduled at the same time
+	kind simEventKind
+
+	msg SimMessage // simDeliver
+
+	node int         // simTimeout
+	ti   timeoutInfo // simTimeout
+	gen  uint64      // simTimeout
+}
+
+// simQueue is a priority queue of events, by time and then scheduling order.
+type simQueue []*simEvent
+
+func (q simQueue) Len() int { return len(q) }
+func (q simQueue) Less(i, j int) bool {
+	if !q[i].at.Equal(q[j].at) {
+		return q[i].at.Before(q[j].at)
+	}
+	return q[i].seq < q[j].seq
+}
+func (q simQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *simQueue) Push(x interface{}) { *q = append(*q, x.(*simEvent)) }
+func (q *simQueue) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return ev
+}
+
+// simTicker is a TimeoutTicker scheduling timeouts on the virtual clock of a
+// Simulation. Like the timeoutTicker, a timeout replaces the pending one if
+// it's for a later height/round/step.
+type simTicker struct {
+	sim  *Simulation
+	node int
+	ti   timeoutInfo
+	gen  uint64 // incremented for each timeout, to discard the replaced ones
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (t *simTicker) Start() error             { return nil }
+func (t *simTicker) Stop() error              { return nil }
+func (t *simTicker) Chan() <-chan timeoutInfo { return nil }
+func (t *simTicker) SetLogger(log.Logger)     {}
+
+func (t *simTicker) ScheduleTimeout(ti timeoutInfo) {
+	if !ti.supersedes(t.ti) {
+		return
+	}
+	t.ti = ti
+	t.gen++
+	t.sim.push(&simEvent{at: t.sim.now.Add(ti.Duration), kind: simTimeout, node: t.node, ti: ti, gen: t.gen})
+}
+
+// simEvidencePool records the evidence detected by a node.
+type simEvidencePool struct {
+	*evidence.EvidencePool
+	added []types.Evidence
+}
+
+func (evpool *simEvidencePool) AddEvidence(ev types.Evidence) error {
+	evpool.added = append(evpool.added, ev)
+	return evpool.EvidencePool.AddEvidence(ev)
+}
+
+//-----------------------------------------------------------------------------
+
+func newTestSimulation(t *testing.T, config SimConfig) *Simulation {
+	sim, err := NewSimulation(config)
+	require.NoError(t, err)
+	return sim
+}
+
+func TestSimulationLiveness(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3, 4, 5} {
+		sim := newTestSimulation(t, SimConfig{
+			Validators: 4,
+			Seed:       seed,
+			MinLatency: 5 * time.Millisecond,
+			MaxLatency: 100 * time.Millisecond,
+		})
+		sim.AddRule(SimDropRate(0.05))
+		assert.NoError(t, sim.RunUntilHeight(10, time.Minute))
+		sim.Stop()
+	}
+}
+
+func TestSimulationReplay(t *testing.T) {
+	run := func() ([][]byte, time.Time) {
+		sim := newTestSimulation(t, SimConfig{
+			Validators: 4,
+			Seed:       42,
+			MinLatency: time.Millisecond,
+			MaxLatency: 200 * time.Millisecond,
+		})
+		defer sim.Stop()
+		sim.AddRule(SimDropRate(0.1))
+		require.NoError(t, sim.RunUntilHeight(5, time.Minute))
+
+		var hashes [][]byte
+		for h := int64(1); h <= 5; h++ {
+			hashes = append(hashes, sim.BlockStore(0).LoadBlockMeta(h).BlockID.Hash)
+		}
+		return hashes, sim.Now()
+	}
+
+	hashes1, end1 := run()
+	hashes2, end2 := run()
+	assert.Equal(t, hashes1, hashes2)
+	assert.Equal(t, end1, end2)
+}
+
+func TestSimulationVirtualClock(t *testing.T) {
+	sim := newTestSimulation(t, SimConfig{
+		Validators: 4,
+		Seed:       5,
+		MinLatency: 5 * time.Millisecond,
+		MaxLatency: 50 * time.Millisecond,
+	})
+	defer sim.Stop()
+	require.NoError(t, sim.RunUntilHeight(3, time.Minute))
+
+	// blocks, votes and proposals are timed by the virtual clock
+	inSim := func(tm time.Time) bool {
+		return !tm.Before(simGenesisTime) && !tm.After(sim.Now())
+	}
+	store := sim.BlockStore(0)
+	for height := int64(1); height <= store.Height(); height++ {
+		block := store.LoadBlock(height)
+		assert.True(t, inSim(block.Time), "block %d time %v", height, block.Time)
+		for _, vote := range store.LoadSeenCommit(height).Precommits {
+			if vote != nil {
+				assert.True(t, inSim(vote.Timestamp), "vote time %v", vote.Timestamp)
+			}
+		}
+	}
+	for i := 0; i < 4; i++ {
+		if proposal := sim.Node(i).Proposal; proposal != nil {
+			assert.True(t, inSim(proposal.Timestamp), "proposal time %v", proposal.Timestamp)
+		}
+	}
+}
+
+func TestSimulationPartition(t *testing.T) {
+	sim := newTestSimulation(t, SimConfig{
+		Validators: 4,
+		Seed:       7,
+		MinLatency: 5 * time.Millisecond,
+		MaxLatency: 50 * time.Millisecond,
+	})
+	defer sim.Stop()
+	require.NoError(t, sim.RunUntilHeight(2, time.Minute))
+
+	// neither half has +2/3 of the voting power
+	start := sim.Elapsed()
+	sim.AddRule(SimPartition(start, start+30*time.Second, []int{0, 1}, []int{2, 3}))
+	require.NoError(t, sim.RunFor(time.Second))
+	height := sim.BlockStore(0).Height()
+	require.NoError(t, sim.RunFor(25*time.Second))
+	for i := 0; i < 4; i++ {
+		assert.True(t, sim.BlockStore(i).Height() <= height+1, "node %d made progress during the partition", i)
+	}
+
+	require.NoError(t, sim.RunUntilHeight(height+3, time.Minute))
+}
+
+func TestSimulationEquivocation(t *testing.T) {
+	sim := newTestSimulation(t, SimConfig{
+		Validators: 4,
+		Seed:       11,
+		MinLatency: 5 * time.Millisecond,
+		MaxLatency: 50 * time.Millisecond,
+	})
+	defer sim.Stop()
+
+	// node 0 sends a prevote for nil along with each of its prevotes for a block
+	privVal := sim.PrivValidator(0)
+	sim.SetOutbound(0, func(m SimMessage) []SimMessage {
+		vm, ok := m.Msg.(*VoteMessage)
+		if !ok || vm.Vote.Type != types.PrevoteType || vm.Vote.BlockID.IsZero() {
+			return []SimMessage{m}
+		}
+		conflicting := vm.Vote.Copy()
+		conflicting.BlockID = types.BlockID{}
+		if err := privVal.SignVote(sim.Node(0).state.ChainID, conflicting); err != nil {
+			t.Fatal(err)
+		}
+		return []SimMessage{m, {From: m.From, To: m.To, Msg: &VoteMessage{conflicting}}}
+	})
+
+	require.NoError(t, sim.RunUntilHeight(5, time.Minute))
+	found := false
+	for i := 1; i < 4; i++ {
+		for _, ev := range sim.Evidence(i) {
+			if _, ok := ev.(*types.DuplicateVoteEvidence); ok {
+				assert.EqualValues(t, privVal.GetPubKey().Address(), ev.Address())
+				found = true
+			}
+		}
+	}
+	assert.True(t, found, "no evidence of the equivocation")
+}
@@ -31,6 +31,7 @@ var (
 	ErrInvalidProposalPOLRound  = errors.New("Error invalid proposal POL round")
 	ErrAddingVote               = errors.New("Error adding vote")
 	ErrVoteHeightMismatch       = errors.New("Error vote height mismatch")
+	ErrInvalidVoteExtension     = errors.New("Error invalid vote extension")
 )
 
 //-----------------------------------------------------------------------------
@@ -57,6 +58,16 @@ func (ti *timeoutInfo) String() string {
 	return fmt.Sprintf("%v ; %d/%d %v", ti.Duration, ti.Height, ti.Round, ti.Step)
 }
 
+// time of a block we're about to propose with proposer-based timestamps,
+// written to the WAL before signing the proposal. If we crash before the
+// proposal is in the WAL, we propose the block again with the same time on
+// replay, so that the signer sees the same proposal, as with votes.
+type proposalTimeInfo struct {
+	Height int64     `json:"height"`
+	Round  int       `json:"round"`
+	Time   time.Time `json:"time"`
+}
+
 // interface to the mempool
 type txNotifier interface {
 	TxsAvailable() <-chan struct{}
@@ -119,9 +130,14 @@ type ConsensusState struct {
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
 
+	// set once we committed the halt height or time;
+	// the receiveRoutine then exits and signals the halt
+	halted bool
+
 	// some functions can be overwritten for testing
 	decideProposal func(height int64, round int)
 	doPrevote      func(height int64, round int)
+	doPrecommit    func(hash []byte, header types.PartSetHeader)
 	setProposal    func(proposal *types.Proposal) error
 
 	// closed when we finish shutting down
@@ -133,6 +149,12 @@ type ConsensusState struct {
 
 	// for reporting metrics
 	metrics *Metrics
+
+	// returns the current time; replaced by the virtual clock in simulations
+	now func() time.Time
+
+	// time of the last block we decided to propose, replayed from the WAL
+	proposalTime proposalTimeInfo
 }
 
 // StateOption sets an optional parameter on the ConsensusState.
@@ -163,21 +185,25 @@ func NewConsensusState(
 		evpool:           evpool,
 		evsw:             tmevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		now:              tmtime.Now,
 	}
 	// set function defaults (may be overwritten before calling Start)
 	cs.decideProposal = cs.defaultDecideProposal
 	cs.doPrevote = cs.defaultDoPrevote
+	cs.doPrecommit = cs.defaultDoPrecommit
 	cs.setProposal = cs.defaultSetProposal
 
+	// options are applied before updating to the state, which uses the clock
+	for _, option := range options {
+		option(cs)
+	}
+
 	cs.updateToState(state)
 
 	// Don't call scheduleRound0 yet.
 	// We do that upon Start().
 	cs.reconstructLastCommit(state)
 	cs.BaseService = *cmn.NewBaseService(nil, "ConsensusState", cs)
-	for _, option := range options {
-		option(cs)
-	}
 	return cs
 }
 
@@ -201,6 +227,11 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *ConsensusState) { cs.metrics = metrics }
 }
 
+// stateClock sets the function returning the current time.
+func stateClock(now func() time.Time) StateOption {
+	return func(cs *ConsensusState) { cs.now = now }
+}
+
 // String returns a string.
 func (cs *ConsensusState) String() string {
 	// better not to access shared variables
@@ -265,12 +296,15 @@ func (cs *ConsensusState) SetTimeoutTicker(timeoutTicker TimeoutTicker) {
 	cs.mtx.Unlock()
 }
 
-// LoadCommit loads the commit for a given height.
+// LoadCommit loads the commit for a given height. The seen commit, stored for
+// the heights committed by consensus, is preferred to the block commit, since
+// it keeps the vote extensions, without which peers catching up would reject
+// the precommits.
 func (cs *ConsensusState) LoadCommit(height int64) *types.Commit {
 	cs.mtx.RLock()
 	defer cs.mtx.RUnlock()
-	if height == cs.blockStore.Height() {
-		return cs.blockStore.LoadSeenCommit(height)
+	if commit := cs.blockStore.LoadSeenCommit(height); commit != nil {
+		return commit
 	}
 	return cs.blockStore.LoadBlockCommit(height)
 }
@@ -303,6 +337,15 @@ func (cs *ConsensusState) OnStart() error {
 		return err
 	}
 
+	// we may have restarted after halting
+	halter := cs.blockExec.Halter()
+	if halter.ShouldHalt(cs.state.LastBlockHeight, cs.state.LastBlockTime) {
+		cs.Logger.Info("Halt height or time already reached", "height", cs.state.LastBlockHeight)
+		cs.halted = true
+		go cs.receiveRoutine(0)
+		return nil
+	}
+
 	// we may have lost some votes if the process crashed
 	// reload from consensus log to catchup
 	if cs.doWALCatchup {
@@ -437,10 +480,40 @@ func (cs *ConsensusState) updateRoundStep(round int, step cstypes.RoundStepType)
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *ConsensusState) scheduleRound0(rs *cstypes.RoundState) {
 	//cs.Logger.Info("scheduleRound0", "now", tmtime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(tmtime.Now()) // nolint: gotype, gosimple
+	sleepDuration := rs.StartTime.Sub(cs.now()) // nolint: gotype, gosimple
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
+// timeouts returns the config to take the consensus timeouts from: a copy of
+// the local config, in which the timeouts set in the consensus params (i.e.
+// non-zero) override the local ones. Since the params can be updated by the app,
+// it's called with the params of the current state whenever a timeout is scheduled.
+func (cs *ConsensusState) timeouts(params types.TimeoutParams) *cfg.ConsensusConfig {
+	config := *cs.config
+	if params.Propose > 0 {
+		config.TimeoutPropose = params.Propose
+	}
+	if params.ProposeDelta > 0 {
+		config.TimeoutProposeDelta = params.ProposeDelta
+	}
+	if params.Prevote > 0 {
+		config.TimeoutPrevote = params.Prevote
+	}
+	if params.PrevoteDelta > 0 {
+		config.TimeoutPrevoteDelta = params.PrevoteDelta
+	}
+	if params.Precommit > 0 {
+		config.TimeoutPrecommit = params.Precommit
+	}
+	if params.PrecommitDelta > 0 {
+		config.TimeoutPrecommitDelta = params.PrecommitDelta
+	}
+	if params.Commit > 0 {
+		config.TimeoutCommit = params.Commit
+	}
+	return &config
+}
+
 // Attempt to schedule a timeout (by sending timeoutInfo on the tickChan)
 func (cs *ConsensusState) scheduleTimeout(duration time.Duration, height int64, round int, step cstypes.RoundStepType) {
 	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
@@ -530,13 +603,14 @@ func (cs *ConsensusState) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		//  cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(tmtime.Now())
+		cs.StartTime = cs.timeouts(state.ConsensusParams.Timeout).Commit(cs.now())
 	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
+		cs.StartTime = cs.timeouts(state.ConsensusParams.Timeout).Commit(cs.CommitTime)
 	}
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -604,6 +678,13 @@ func (cs *ConsensusState) receiveRoutine(maxSteps int) {
 	}()
 
 	for {
+		if cs.halted {
+			// the state is saved and the WAL closed by now,
+			// so the node can safely exit
+			onExit(cs)
+			cs.blockExec.Halter().Halt()
+			return
+		}
 		if maxSteps > 0 {
 			if cs.nSteps >= maxSteps {
 				cs.Logger.Info("reached max steps. exiting receive routine")
@@ -753,7 +834,13 @@ func (cs *ConsensusState) enterNewRound(height int64, round int) {
 		return
 	}
 
-	if now := tmtime.Now(); cs.StartTime.After(now) {
+	// Don't start the height after the halt, eg. when skipping timeout commit.
+	if cs.halted {
+		logger.Debug("enterNewRound: halted")
+		return
+	}
+
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Info("Need to set a buffer and log message here for sanity.", "startTime", cs.StartTime, "now", now)
 	}
 
@@ -778,6 +865,7 @@ func (cs *ConsensusState) enterNewRound(height int64, round int) {
 	} else {
 		logger.Info("Resetting Proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -838,7 +926,7 @@ func (cs *ConsensusState) enterPropose(height int64, round int) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.timeouts(cs.state.ConsensusParams.Timeout).Propose(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -882,9 +970,19 @@ func (cs *ConsensusState) defaultDecideProposal(height int64, round int) {
 		}
 	}
 
+	if cs.state.ConsensusParams.Timestamp.ProposerBased && block != cs.ValidBlock && !cs.replayMode {
+		cs.proposalTime = proposalTimeInfo{height, round, block.Time}
+		cs.wal.WriteSync(cs.proposalTime) // NOTE: fsync
+	}
+
 	// Make proposal
 	propBlockId := types.BlockID{block.Hash(), blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockId)
+	proposal.Timestamp = cs.now()
+	if cs.state.ConsensusParams.Timestamp.ProposerBased {
+		// Validators check that the proposal is timely against the block time.
+		proposal.Timestamp = block.Time
+	}
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, proposal); err == nil {
 
 		// send proposal and block parts on internal msg queue
@@ -930,16 +1028,28 @@ func (cs *ConsensusState) createProposalBlock() (block *types.Block, blockParts
 		// The commit is empty, but not nil.
 		commit = &types.Commit{}
 	} else if cs.LastCommit.HasTwoThirdsMajority() {
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit, keeping the vote extensions for the app
+		commit = cs.LastCommit.MakeExtendedCommit()
 	} else {
 		// This shouldn't happen.
 		cs.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block.")
 		return
 	}
 
+	// With proposer-based timestamps, propose again at the time we decided on
+	// before a restart
+	now := cs.now()
+	if pt := cs.proposalTime; pt.Height == cs.Height && pt.Round == cs.Round {
+		now = pt.Time
+	}
+
 	proposerAddr := cs.privValidator.GetPubKey().Address()
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	block, blockParts, err := cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, now)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Error creating proposal block", "height", cs.Height, "err", err)
+		return nil, nil
+	}
+	return block, blockParts
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -993,6 +1103,28 @@ func (cs *ConsensusState) defaultDoPrevote(height int64, round int) {
 		return
 	}
 
+	// With proposer-based timestamps, a new proposal block must be timely
+	if err := cs.checkProposalTimely(); err != nil {
+		logger.Info("enterPrevote: ProposalBlock is not timely", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// Let the app reject the proposal block
+	accept, err := cs.blockExec.ProcessProposal(cs.ProposalBlock)
+	if err != nil {
+		// Can't tell if the app accepts ProposalBlock, prevote nil.
+		logger.Error("enterPrevote: Error processing ProposalBlock", "err", err)
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	if !accept {
+		// ProposalBlock is rejected by the app, prevote nil.
+		logger.Error("enterPrevote: ProposalBlock is rejected by the app")
+		cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1000,6 +1132,25 @@ func (cs *ConsensusState) defaultDoPrevote(height int64, round int) {
 	cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
 }
 
+// checkProposalTimely returns an error if proposer-based timestamps are on and
+// the proposal of a new block (i.e. without POLRound) wasn't received
+// within the window of the consensus params around the block time.
+// Blocks proposed again with a POLRound were already checked by +2/3 of the validators.
+func (cs *ConsensusState) checkProposalTimely() error {
+	params := cs.state.ConsensusParams.Timestamp
+	if !params.ProposerBased || cs.Proposal == nil || cs.Proposal.POLRound != -1 {
+		return nil
+	}
+	if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+		return fmt.Errorf("proposal time %v differs from block time %v", cs.Proposal.Timestamp, cs.ProposalBlock.Time)
+	}
+	if !params.IsTimely(cs.ProposalBlock.Time, cs.ProposalReceiveTime) {
+		return fmt.Errorf("block time %v is too far from receive time %v (precision %v, message delay %v)",
+			cs.ProposalBlock.Time, cs.ProposalReceiveTime, params.Precision, params.MessageDelay)
+	}
+	return nil
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *ConsensusState) enterPrevoteWait(height int64, round int) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1020,7 +1171,7 @@ func (cs *ConsensusState) enterPrevoteWait(height int64, round int) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.timeouts(cs.state.ConsensusParams.Timeout).Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1055,7 +1206,7 @@ func (cs *ConsensusState) enterPrecommit(height int64, round int) {
 		} else {
 			logger.Info("enterPrecommit: No +2/3 prevotes during enterPrecommit. Precommitting nil.")
 		}
-		cs.signAddVote(types.PrecommitType, nil, types.PartSetHeader{})
+		cs.doPrecommit(nil, types.PartSetHeader{})
 		return
 	}
 
@@ -1079,7 +1230,7 @@ func (cs *ConsensusState) enterPrecommit(height int64, round int) {
 			cs.LockedBlockParts = nil
 			cs.eventBus.PublishEventUnlock(cs.RoundStateEvent())
 		}
-		cs.signAddVote(types.PrecommitType, nil, types.PartSetHeader{})
+		cs.doPrecommit(nil, types.PartSetHeader{})
 		return
 	}
 
@@ -1090,7 +1241,7 @@ func (cs *ConsensusState) enterPrecommit(height int64, round int) {
 		logger.Info("enterPrecommit: +2/3 prevoted locked block. Relocking")
 		cs.LockedRound = round
 		cs.eventBus.PublishEventRelock(cs.RoundStateEvent())
-		cs.signAddVote(types.PrecommitType, blockID.Hash, blockID.PartsHeader)
+		cs.doPrecommit(blockID.Hash, blockID.PartsHeader)
 		return
 	}
 
@@ -1105,7 +1256,7 @@ func (cs *ConsensusState) enterPrecommit(height int64, round int) {
 		cs.LockedBlock = cs.ProposalBlock
 		cs.LockedBlockParts = cs.ProposalBlockParts
 		cs.eventBus.PublishEventLock(cs.RoundStateEvent())
-		cs.signAddVote(types.PrecommitType, blockID.Hash, blockID.PartsHeader)
+		cs.doPrecommit(blockID.Hash, blockID.PartsHeader)
 		return
 	}
 
@@ -1121,7 +1272,11 @@ func (cs *ConsensusState) enterPrecommit(height int64, round int) {
 		cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartsHeader)
 	}
 	cs.eventBus.PublishEventUnlock(cs.RoundStateEvent())
-	cs.signAddVote(types.PrecommitType, nil, types.PartSetHeader{})
+	cs.doPrecommit(nil, types.PartSetHeader{})
+}
+
+func (cs *ConsensusState) defaultDoPrecommit(hash []byte, header types.PartSetHeader) {
+	cs.signAddVote(types.PrecommitType, hash, header)
 }
 
 // Enter: any +2/3 precommits for next round.
@@ -1148,7 +1303,7 @@ func (cs *ConsensusState) enterPrecommitWait(height int64, round int) {
 	}()
 
 	// Wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.timeouts(cs.state.ConsensusParams.Timeout).Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
 
 }
 
@@ -1167,7 +1322,7 @@ func (cs *ConsensusState) enterCommit(height int64, commitRound int) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = tmtime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1260,9 +1415,11 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 	// Save to blockStore.
 	if cs.blockStore.Height() < block.Height {
 		// NOTE: the seenCommit is local justification to commit this block,
-		// but may differ from the LastCommit included in the next block
+		// but may differ from the LastCommit included in the next block.
+		// It keeps the vote extensions, so they're still passed to the app
+		// when proposing the next block after a restart.
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit := precommits.MakeCommit()
+		seenCommit := precommits.MakeExtendedCommit()
 		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
@@ -1314,6 +1471,16 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 
 	fail.Fail() // XXX
 
+	// Stop here if we committed the halt height or time.
+	// The receiveRoutine exits once we return.
+	halter := cs.blockExec.Halter()
+	if halter.ShouldHalt(height, block.Time) {
+		cs.Logger.Info("Halting consensus", "height", height,
+			"haltHeight", halter.HaltHeight(), "haltTime", halter.HaltTime())
+		cs.halted = true
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1390,6 +1557,7 @@ func (cs *ConsensusState) defaultSetProposal(proposal *types.Proposal) error {
 	}
 
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = cs.now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1511,6 +1679,9 @@ func (cs *ConsensusState) addVote(vote *types.Vote, peerID p2p.ID) (added bool,
 			// fmt.Errorf("tryAddVote: Wrong height, not a LastCommit straggler commit.")
 			return added, ErrVoteHeightMismatch
 		}
+		if err = cs.verifyVoteExtension(vote, cs.LastValidators, cs.LastCommit); err != nil {
+			return added, err
+		}
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return added, err
@@ -1538,6 +1709,10 @@ func (cs *ConsensusState) addVote(vote *types.Vote, peerID p2p.ID) (added bool,
 		return
 	}
 
+	if err = cs.verifyVoteExtension(vote, cs.Validators, cs.Votes.Precommits(vote.Round)); err != nil {
+		return added, err
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
@@ -1646,6 +1821,50 @@ func (cs *ConsensusState) addVote(vote *types.Vote, peerID p2p.ID) (added bool,
 	return
 }
 
+// verifyVoteExtension asks the app to verify the extension of another
+// validator's precommit for a block. The signatures of the vote and of the
+// extension, which is required even if the extension is empty, are verified
+// first, so that the app only sees extensions signed by the given validator.
+// Precommits already in votes aren't verified again, as the vote set drops
+// them as duplicates anyway.
+func (cs *ConsensusState) verifyVoteExtension(vote *types.Vote, valSet *types.ValidatorSet, votes *types.VoteSet) error {
+	if !vote.IsExtendable() {
+		return nil
+	}
+	if cs.privValidator != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidator.GetPubKey().Address()) {
+		return nil
+	}
+
+	addr, val := valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return types.ErrVoteInvalidValidatorIndex
+	}
+	if !bytes.Equal(addr, vote.ValidatorAddress) {
+		return types.ErrVoteInvalidValidatorAddress
+	}
+	// a precommit we already have was verified when it was added
+	if known := votes.GetByIndex(vote.ValidatorIndex); known != nil && bytes.Equal(known.Signature, vote.Signature) {
+		return nil
+	}
+	if err := vote.Verify(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+
+	accept, err := cs.blockExec.VerifyVoteExtension(vote)
+	if err != nil {
+		return err
+	}
+	if !accept {
+		cs.Logger.Info("Vote extension rejected by the app", "height", vote.Height, "round", vote.Round,
+			"validator", vote.ValidatorAddress)
+		return ErrInvalidVoteExtension
+	}
+	return nil
+}
+
 func (cs *ConsensusState) signVote(type_ types.SignedMsgType, hash []byte, header types.PartSetHeader) (*types.Vote, error) {
 	addr := cs.privValidator.GetPubKey().Address()
 	valIndex, _ := cs.Validators.GetByAddress(addr)
@@ -1659,12 +1878,23 @@ func (cs *ConsensusState) signVote(type_ types.SignedMsgType, hash []byte, heade
 		Type:             type_,
 		BlockID:          types.BlockID{hash, header},
 	}
+	if vote.IsExtendable() {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return vote, err
+		}
+		vote.Extension = ext
+	}
 	err := cs.privValidator.SignVote(cs.state.ChainID, vote)
 	return vote, err
 }
 
 func (cs *ConsensusState) voteTime() time.Time {
-	now := tmtime.Now()
+	now := cs.now()
+	// Vote times are only used for the median block time.
+	if cs.state.ConsensusParams.Timestamp.ProposerBased {
+		return now
+	}
 	minVoteTime := now
 	// TODO: We should remove next line in case we don't vote for v in case cs.ProposalBlock == nil,
 	// even if cs.LockedBlock != nil. See https://github.com/tendermint/spec.
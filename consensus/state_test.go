@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
@@ -231,6 +234,416 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, types.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+// processProposalApp accepts or rejects every proposal
+type processProposalApp struct {
+	abci.BaseApplication
+
+	accept bool
+}
+
+func (app *processProposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	return abci.ResponseProcessProposal{Accept: app.accept}
+}
+
+// a valid proposal should be prevoted only if the app accepts it
+func TestStateProcessProposal(t *testing.T) {
+	for _, accept := range []bool{true, false} {
+		state, privVals := randGenesisState(2, false, 10)
+		cs1 := newConsensusState(state, privVals[0], &processProposalApp{accept: accept})
+		vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+		incrementHeight(vs2)
+		height, round := cs1.Height, cs1.Round
+
+		proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+		voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+		propBlock, propBlockParts := cs1.createProposalBlock()
+		require.NotNil(t, propBlock)
+
+		// make the second validator the proposer by incrementing round
+		round = round + 1
+		incrementRound(vs2)
+
+		blockID := types.BlockID{propBlock.Hash(), propBlockParts.Header()}
+		proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+		if err := vs2.SignProposal(config.ChainID(), proposal); err != nil {
+			t.Fatal("failed to sign proposal", err)
+		}
+		if err := cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"); err != nil {
+			t.Fatal(err)
+		}
+
+		startTestRound(cs1, height, round)
+		ensureProposal(proposalCh, height, round, blockID)
+
+		// prevote the block only if it's accepted
+		ensurePrevote(voteCh, height, round)
+		if accept {
+			validatePrevote(t, cs1, round, vs1, propBlock.Hash())
+		} else {
+			validatePrevote(t, cs1, round, vs1, nil)
+		}
+
+		cs1.Stop()
+	}
+}
+
+// with proposer-based timestamps, a new proposal block should be prevoted
+// only if it was received within the precision and message delay of its time
+func TestStateProposerBasedTimestamp(t *testing.T) {
+	for _, timely := range []bool{true, false} {
+		state, privVals := randGenesisState(2, false, 10)
+		state.ConsensusParams.Timestamp = types.TimestampParams{
+			ProposerBased: true,
+			Precision:     time.Second,
+			MessageDelay:  time.Second,
+		}
+		cs1 := newConsensusState(state, privVals[0], abci.NewBaseApplication())
+		vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+		incrementHeight(vs2)
+		height, round := cs1.Height, cs1.Round
+
+		proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+		voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+		propBlock, _ := cs1.createProposalBlock()
+		require.NotNil(t, propBlock)
+		if !timely {
+			// still a valid block time, but too far ahead of our clock
+			propBlock.Time = propBlock.Time.Add(time.Hour)
+		}
+		propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+
+		// make the second validator the proposer by incrementing round
+		round = round + 1
+		incrementRound(vs2)
+
+		blockID := types.BlockID{propBlock.Hash(), propBlockParts.Header()}
+		proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+		proposal.Timestamp = propBlock.Time
+		if err := vs2.SignProposal(config.ChainID(), proposal); err != nil {
+			t.Fatal("failed to sign proposal", err)
+		}
+		if err := cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"); err != nil {
+			t.Fatal(err)
+		}
+
+		startTestRound(cs1, height, round)
+		ensureProposal(proposalCh, height, round, blockID)
+
+		// prevote the block only if it's timely
+		ensurePrevote(voteCh, height, round)
+		if timely {
+			validatePrevote(t, cs1, round, vs1, propBlock.Hash())
+		} else {
+			validatePrevote(t, cs1, round, vs1, nil)
+		}
+
+		cs1.Stop()
+	}
+}
+
+// with proposer-based timestamps, a proposer restarting in the propose step
+// should propose the block again at the time it decided on before
+func TestStateProposerBasedTimestampReplay(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10)
+	state.ConsensusParams.Timestamp = types.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  time.Second,
+	}
+	cs1 := newConsensusState(state, privVals[0], abci.NewBaseApplication())
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	proposalTime := state.LastBlockTime.Add(time.Minute)
+	msg := &TimedWALMessage{Time: proposalTime, Msg: proposalTimeInfo{height, round, proposalTime}}
+	require.NoError(t, cs1.readReplayMessage(msg, nil))
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	assert.True(t, proposalTime.Equal(rs.ProposalBlock.Time))
+	assert.True(t, proposalTime.Equal(rs.Proposal.Timestamp))
+
+	cs1.Stop()
+}
+
+// timeouts set in the consensus params should override the local config
+func TestStateTimeoutParams(t *testing.T) {
+	cs, _ := randConsensusState(1)
+
+	timeouts := cs.timeouts(types.TimeoutParams{})
+	assert.Equal(t, cs.config.Propose(1), timeouts.Propose(1))
+	assert.Equal(t, cs.config.Prevote(1), timeouts.Prevote(1))
+	assert.Equal(t, cs.config.Precommit(1), timeouts.Precommit(1))
+
+	now := time.Now()
+	timeouts = cs.timeouts(types.TimeoutParams{Propose: time.Hour, PrecommitDelta: time.Second, Commit: time.Minute})
+	assert.Equal(t, time.Hour+cs.config.TimeoutProposeDelta, timeouts.Propose(1))
+	assert.Equal(t, cs.config.Prevote(1), timeouts.Prevote(1))
+	assert.Equal(t, cs.config.TimeoutPrecommit+time.Second, timeouts.Precommit(1))
+	assert.Equal(t, now.Add(time.Minute), timeouts.Commit(now))
+
+	// the local config is left unchanged
+	assert.NotEqual(t, time.Hour, cs.config.TimeoutPropose)
+}
+
+// recordingTicker records the timeouts scheduled through it
+type recordingTicker struct {
+	TimeoutTicker
+
+	mtx       sync.Mutex
+	scheduled []timeoutInfo
+}
+
+func (rt *recordingTicker) ScheduleTimeout(ti timeoutInfo) {
+	rt.mtx.Lock()
+	rt.scheduled = append(rt.scheduled, ti)
+	rt.mtx.Unlock()
+	rt.TimeoutTicker.ScheduleTimeout(ti)
+}
+
+func (rt *recordingTicker) durations(step cstypes.RoundStepType) []time.Duration {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+	var durations []time.Duration
+	for _, ti := range rt.scheduled {
+		if ti.Step == step {
+			durations = append(durations, ti.Duration)
+		}
+	}
+	return durations
+}
+
+// the state machine should wait for the timeouts set in the consensus params
+// at every step, rather than for the local ones
+func TestStateTimeoutParamsSteps(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	params := types.TimeoutParams{
+		Propose:        60 * time.Millisecond,
+		ProposeDelta:   7 * time.Millisecond,
+		Prevote:        20 * time.Millisecond,
+		PrevoteDelta:   2 * time.Millisecond,
+		Precommit:      30 * time.Millisecond,
+		PrecommitDelta: 3 * time.Millisecond,
+	}
+	state.ConsensusParams.Timeout = params
+	cs1 := newConsensusState(state, privVals[0], abci.NewBaseApplication())
+	ticker := &recordingTicker{TimeoutTicker: cs1.timeoutTicker}
+	cs1.SetTimeoutTicker(ticker)
+	vs2 := NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	timeoutProposeCh := subscribe(cs1.eventBus, types.EventQueryTimeoutPropose)
+	timeoutWaitCh := subscribe(cs1.eventBus, types.EventQueryTimeoutWait)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// prevotes for our block and nil: wait for the prevote timeout
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensureNewTimeout(timeoutWaitCh, height, round, params.Prevote.Nanoseconds())
+
+	// nil precommits: wait for the precommit timeout, then start the next round
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, types.PrecommitType, nil, types.PartSetHeader{}, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewTimeout(timeoutWaitCh, height, round, params.Precommit.Nanoseconds())
+	ensureNewRound(newRoundCh, height, round+1)
+
+	// no proposal from the other validator: wait for the propose timeout
+	ensureNewTimeout(timeoutProposeCh, height, round+1, (params.Propose + params.ProposeDelta).Nanoseconds())
+
+	assert.Equal(t, []time.Duration{params.Propose, params.Propose + params.ProposeDelta},
+		ticker.durations(cstypes.RoundStepPropose))
+	assert.Equal(t, []time.Duration{params.Prevote}, ticker.durations(cstypes.RoundStepPrevoteWait))
+	assert.Equal(t, []time.Duration{params.Precommit}, ticker.durations(cstypes.RoundStepPrecommitWait))
+
+	cs1.Stop()
+}
+
+// the receive routine exits after committing the halt height,
+// without starting the next height, and the halt is signalled
+func TestStateHaltHeight(t *testing.T) {
+	cs1, _ := randConsensusState(1)
+	cs1.blockExec.Halter().SetHalt(1, time.Time{})
+	height, round := cs1.Height, cs1.Round
+
+	startTestRound(cs1, height, round)
+	defer cs1.Stop()
+
+	select {
+	case <-cs1.blockExec.Halter().Halted():
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the halt")
+	}
+	// the WAL is closed and the receive routine done
+	cs1.Wait()
+
+	assert.Equal(t, int64(1), cs1.GetState().LastBlockHeight)
+	rs := cs1.GetRoundState()
+	assert.Equal(t, int64(2), rs.Height)
+	assert.Equal(t, cstypes.RoundStepNewHeight, rs.Step)
+	assert.Nil(t, rs.Proposal)
+}
+
+// voteExtensionApp extends precommits with a fixed extension
+// and only accepts that extension from other validators
+type voteExtensionApp struct {
+	abci.BaseApplication
+
+	extension []byte
+	verified  int32 // number of VerifyVoteExtension calls
+}
+
+func (app *voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: app.extension}
+}
+
+func (app *voteExtensionApp) VerifyVoteExtension(req abci.RequestVerifyVoteExtension) abci.ResponseVerifyVoteExtension {
+	atomic.AddInt32(&app.verified, 1)
+	return abci.ResponseVerifyVoteExtension{Accept: bytes.Equal(req.VoteExtension, app.extension)}
+}
+
+// our precommit should carry the app's extension,
+// and a precommit should be added only if the app accepts its extension
+func TestStateVoteExtension(t *testing.T) {
+	extension := []byte("extension")
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newConsensusState(state, privVals[0], &voteExtensionApp{extension: extension})
+	vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propBlockParts := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, types.PrevoteType, propBlockHash, propBlockParts, vs2)
+	ensurePrevote(voteCh, height, round)
+
+	ensurePrecommit(voteCh, height, round)
+	validatePrecommit(t, cs1, round, round, vs1, propBlockHash, propBlockHash)
+	precommit := cs1.Votes.Precommits(round).GetByAddress(vs1.GetPubKey().Address())
+	assert.Equal(t, extension, precommit.Extension)
+	assert.NoError(t, precommit.Verify(config.ChainID(), vs1.GetPubKey()))
+
+	signPrecommit := func(ext []byte) *types.Vote {
+		vote := signVote(vs2, types.PrecommitType, propBlockHash, propBlockParts)
+		vote.Extension = ext
+		if err := vs2.SignVote(config.ChainID(), vote); err != nil {
+			t.Fatal("failed to sign vote", err)
+		}
+		return vote
+	}
+
+	// the app rejects the extension, so the precommit isn't added
+	addVotes(cs1, signPrecommit([]byte("bad extension")))
+	ensureNoNewEventOnChannel(voteCh)
+
+	addVotes(cs1, signPrecommit(extension))
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	// the seen commit keeps the extensions, unlike the commit of the next block
+	seenCommit := cs1.blockStore.LoadSeenCommit(height)
+	for _, precommit := range seenCommit.Precommits {
+		assert.Equal(t, extension, precommit.Extension)
+	}
+
+	cs1.Stop()
+}
+
+// the app verifies the extension of a precommit only once,
+// not every time a peer gossips it again
+func TestStateVoteExtensionVerifiedOnce(t *testing.T) {
+	extension := []byte("extension")
+	state, privVals := randGenesisState(3, false, 10)
+	app := &voteExtensionApp{extension: extension}
+	cs1 := newConsensusState(state, privVals[0], app)
+	vs2 := NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propBlockParts := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+	ensurePrevote(voteCh, height, round)
+
+	vote := signVote(vs2, types.PrecommitType, propBlockHash, propBlockParts)
+	vote.Extension = extension
+	if err := vs2.SignVote(config.ChainID(), vote); err != nil {
+		t.Fatal("failed to sign vote", err)
+	}
+	addVotes(cs1, vote)
+	ensurePrecommit(voteCh, height, round)
+
+	addVotes(cs1, vote)
+	ensureNoNewEventOnChannel(voteCh)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&app.verified))
+
+	cs1.Stop()
+}
+
+// empty extensions are signed too, so that a precommit whose extension was
+// stripped by a relaying peer isn't added
+func TestStateVoteEmptyExtension(t *testing.T) {
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newConsensusState(state, privVals[0], &voteExtensionApp{})
+	vs1, vs2 := NewValidatorStub(privVals[0], 0), NewValidatorStub(privVals[1], 1)
+	incrementHeight(vs2)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	propBlockHash, propBlockParts := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	ensurePrevote(voteCh, height, round)
+	signAddVotes(cs1, types.PrevoteType, propBlockHash, propBlockParts, vs2)
+	ensurePrevote(voteCh, height, round)
+
+	ensurePrecommit(voteCh, height, round)
+	precommit := cs1.Votes.Precommits(round).GetByAddress(vs1.GetPubKey().Address())
+	assert.Empty(t, precommit.Extension)
+	assert.NoError(t, precommit.VerifyExtension(config.ChainID(), vs1.GetPubKey()))
+
+	vote := signVote(vs2, types.PrecommitType, propBlockHash, propBlockParts)
+	addVotes(cs1, vote.StripExtension())
+	ensureNoNewEventOnChannel(voteCh)
+
+	addVotes(cs1, vote)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	cs1.Stop()
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -100,16 +100,8 @@ func (t *timeoutTicker) timeoutRoutine() {
 			t.Logger.Debug("Received tick", "old_ti", ti, "new_ti", newti)
 
 			// ignore tickers for old height/round/step
-			if newti.Height < ti.Height {
+			if !newti.supersedes(ti) {
 				continue
-			} else if newti.Height == ti.Height {
-				if newti.Round < ti.Round {
-					continue
-				} else if newti.Round == ti.Round {
-					if ti.Step > 0 && newti.Step <= ti.Step {
-						continue
-					}
-				}
 			}
 
 			// stop the last timer
@@ -132,3 +124,15 @@ func (t *timeoutTicker) timeoutRoutine() {
 		}
 	}
 }
+
+// supersedes returns true if the timeout is for a later height/round/step
+// than the given one, and so replaces it.
+func (ti timeoutInfo) supersedes(old timeoutInfo) bool {
+	if ti.Height != old.Height {
+		return ti.Height > old.Height
+	}
+	if ti.Round != old.Round {
+		return ti.Round > old.Round
+	}
+	return old.Step == 0 || ti.Step > old.Step
+}
@@ -72,6 +72,7 @@ type RoundState struct {
 	CommitTime                time.Time           `json:"commit_time"` // Subjective time when +2/3 precommits for Block at Round were found
 	Validators                *types.ValidatorSet `json:"validators"`
 	Proposal                  *types.Proposal     `json:"proposal"`
+	ProposalReceiveTime       time.Time           `json:"proposal_receive_time"` // Local time when Proposal was received
 	ProposalBlock             *types.Block        `json:"proposal_block"`
 	ProposalBlockParts        *types.PartSet      `json:"proposal_block_parts"`
 	LockedRound               int                 `json:"locked_round"`
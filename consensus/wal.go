@@ -45,6 +45,7 @@ func RegisterWALMessages(cdc *amino.Codec) {
 	cdc.RegisterConcrete(msgInfo{}, "tendermint/wal/MsgInfo", nil)
 	cdc.RegisterConcrete(timeoutInfo{}, "tendermint/wal/TimeoutInfo", nil)
 	cdc.RegisterConcrete(EndHeightMessage{}, "tendermint/wal/EndHeightMessage", nil)
+	cdc.RegisterConcrete(proposalTimeInfo{}, "tendermint/wal/ProposalTimeInfo", nil)
 }
 
 //--------------------------------------------------------
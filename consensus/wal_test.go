@@ -69,6 +69,7 @@ func TestWALEncoderDecoder(t *testing.T) {
 	msgs := []TimedWALMessage{
 		TimedWALMessage{Time: now, Msg: EndHeightMessage{0}},
 		TimedWALMessage{Time: now, Msg: timeoutInfo{Duration: time.Second, Height: 1, Round: 1, Step: types.RoundStepPropose}},
+		TimedWALMessage{Time: now, Msg: proposalTimeInfo{Height: 1, Round: 1, Time: now}},
 	}
 
 	b := new(bytes.Buffer)
@@ -23,14 +23,18 @@ type EvidencePool struct {
 	// needed to load validators to verify evidence
 	stateDB dbm.DB
 
+	// needed to verify light client attack evidence
+	blockStore sm.BlockStoreRPC
+
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
 }
 
-func NewEvidencePool(stateDB dbm.DB, evidenceStore *EvidenceStore) *EvidencePool {
+func NewEvidencePool(stateDB dbm.DB, blockStore sm.BlockStoreRPC, evidenceStore *EvidenceStore) *EvidencePool {
 	evpool := &EvidencePool{
 		stateDB:       stateDB,
+		blockStore:    blockStore,
 		state:         sm.LoadState(stateDB),
 		logger:        log.NewNopLogger(),
 		evidenceStore: evidenceStore,
@@ -87,13 +91,20 @@ func (evpool *EvidencePool) Update(block *types.Block, state sm.State) {
 	evpool.MarkEvidenceAsCommitted(block.Height, block.Evidence.Evidence)
 }
 
-// AddEvidence checks the evidence is valid and adds it to the pool.
+// AddEvidence checks the evidence is valid and fits in a block, and adds it to
+// the pool.
 func (evpool *EvidencePool) AddEvidence(evidence types.Evidence) (err error) {
 
 	// TODO: check if we already have evidence for this
 	// validator at this height so we dont get spammed
 
-	if err := sm.VerifyEvidence(evpool.stateDB, evpool.State(), evidence); err != nil {
+	// evidence which doesn't fit in a block would never be committed
+	_, maxBytes := types.MaxEvidencePerBlock(evpool.State().ConsensusParams.BlockSize.MaxBytes)
+	if size := (types.EvidenceList{evidence}).ByteSize(); size > maxBytes {
+		return fmt.Errorf("Evidence is too big: max %d bytes, got %d", maxBytes, size)
+	}
+
+	if err := sm.VerifyEvidence(evpool.stateDB, evpool.blockStore, evpool.State(), evidence); err != nil {
 		return err
 	}
 
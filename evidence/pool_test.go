@@ -15,6 +15,8 @@ import (
 
 var mockState = sm.State{}
 
+const evidenceChainID = "evidence_test"
+
 func TestMain(m *testing.M) {
 	types.RegisterMockEvidences(cdc)
 
@@ -23,21 +25,26 @@ func TestMain(m *testing.M) {
 }
 
 func initializeValidatorState(valAddr []byte, height int64) dbm.DB {
-	stateDB := dbm.NewMemDB()
-
-	// create validator set and state
 	valSet := &types.ValidatorSet{
 		Validators: []*types.Validator{
 			{Address: valAddr},
 		},
 	}
+	return initializeStateFromValidatorSet(valSet, height)
+}
+
+func initializeStateFromValidatorSet(valSet *types.ValidatorSet, height int64) dbm.DB {
+	stateDB := dbm.NewMemDB()
+
 	state := sm.State{
+		ChainID:                     evidenceChainID,
 		LastBlockHeight:             0,
 		LastBlockTime:               tmtime.Now(),
 		Validators:                  valSet,
 		NextValidators:              valSet.CopyIncrementProposerPriority(1),
 		LastHeightValidatorsChanged: 1,
 		ConsensusParams: types.ConsensusParams{
+			BlockSize: types.DefaultBlockSizeParams(),
 			Evidence: types.EvidenceParams{
 				MaxAge: 1000000,
 			},
@@ -59,7 +66,7 @@ func TestEvidencePool(t *testing.T) {
 	height := int64(5)
 	stateDB := initializeValidatorState(valAddr, height)
 	store := NewEvidenceStore(dbm.NewMemDB())
-	pool := NewEvidencePool(stateDB, store)
+	pool := NewEvidencePool(stateDB, nil, store)
 
 	goodEvidence := types.NewMockGoodEvidence(height, 0, valAddr)
 	badEvidence := types.MockBadEvidence{goodEvidence}
@@ -86,3 +93,27 @@ func TestEvidencePool(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, pool.evidenceList.Len())
 }
+
+func TestEvidencePoolMaxEvidenceBytes(t *testing.T) {
+	valAddr := []byte("val1")
+	height := int64(5)
+	ev := types.NewMockGoodEvidence(height, 0, valAddr)
+
+	// evidence takes up to 1/10 of a block
+	for _, tc := range []struct {
+		blockMaxBytes int64
+		expectErr     bool
+	}{
+		{types.MaxEvidenceBytes * types.MaxEvidenceBytesDenominator, false},
+		{types.MaxEvidenceBytes*types.MaxEvidenceBytesDenominator - 1, true},
+	} {
+		stateDB := initializeValidatorState(valAddr, height)
+		state := sm.LoadState(stateDB)
+		state.ConsensusParams.BlockSize.MaxBytes = tc.blockMaxBytes
+		sm.SaveState(stateDB, state)
+
+		pool := NewEvidencePool(stateDB, nil, NewEvidenceStore(dbm.NewMemDB()))
+		err := pool.AddEvidence(ev)
+		assert.Equal(t, tc.expectErr, err != nil, "block max bytes %d: %v", tc.blockMaxBytes, err)
+	}
+}
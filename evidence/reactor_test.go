@@ -8,13 +8,17 @@ import (
 
 	"github.com/go-kit/kit/log/term"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/p2p"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // evidenceLogger is a TestingLogger which uses a different
@@ -31,14 +35,15 @@ func evidenceLogger() log.Logger {
 }
 
 // connect N evidence reactors through N switches
-func makeAndConnectEvidenceReactors(config *cfg.Config, stateDBs []dbm.DB) []*EvidenceReactor {
+func makeAndConnectEvidenceReactors(config *cfg.Config, stateDBs []dbm.DB,
+	blockStore sm.BlockStoreRPC) []*EvidenceReactor {
 	N := len(stateDBs)
 	reactors := make([]*EvidenceReactor, N)
 	logger := evidenceLogger()
 	for i := 0; i < N; i++ {
 
 		store := NewEvidenceStore(dbm.NewMemDB())
-		pool := NewEvidencePool(stateDBs[i], store)
+		pool := NewEvidencePool(stateDBs[i], blockStore, store)
 		reactors[i] = NewEvidenceReactor(pool)
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
@@ -128,7 +133,7 @@ func TestReactorBroadcastEvidence(t *testing.T) {
 	}
 
 	// make reactors from statedb
-	reactors := makeAndConnectEvidenceReactors(config, stateDBs)
+	reactors := makeAndConnectEvidenceReactors(config, stateDBs, nil)
 
 	// set the peer height on each reactor
 	for _, r := range reactors {
@@ -164,7 +169,7 @@ func TestReactorSelectiveBroadcast(t *testing.T) {
 	stateDB2 := initializeValidatorState(valAddr, height2)
 
 	// make reactors from statedb
-	reactors := makeAndConnectEvidenceReactors(config, []dbm.DB{stateDB1, stateDB2})
+	reactors := makeAndConnectEvidenceReactors(config, []dbm.DB{stateDB1, stateDB2}, nil)
 
 	// set the peer height on each reactor
 	for _, r := range reactors {
@@ -189,6 +194,95 @@ func TestReactorSelectiveBroadcast(t *testing.T) {
 	peers := reactors[1].Switch.Peers().List()
 	assert.Equal(t, 1, len(peers))
 }
+func TestReactorBroadcastLightClientAttackEvidence(t *testing.T) {
+	config := cfg.TestConfig()
+	height := int64(10)
+
+	// a lunatic attack by a large validator set
+	vals, privVals := types.RandValidatorSet(300, 10)
+	ev := makeLunaticAttackEvidence(t, height, privVals)
+
+	// peers don't decode bigger messages
+	msgBytes := cdc.MustMarshalBinaryBare(&EvidenceListMessage{[]types.Evidence{ev}})
+	require.True(t, len(msgBytes) <= maxMsgSize, "message of %d bytes, max %d", len(msgBytes), maxMsgSize)
+
+	// the chain committed another block at the height
+	blockStore := mockBlockStore{types.BlockID{Hash: tmhash.Sum([]byte("block"))}}
+	stateDBs := []dbm.DB{
+		initializeStateFromValidatorSet(vals, height+5),
+		initializeStateFromValidatorSet(vals, height+5),
+	}
+	reactors := makeAndConnectEvidenceReactors(config, stateDBs, blockStore)
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{height + 5})
+		}
+	}
+
+	require.NoError(t, reactors[0].evpool.AddEvidence(ev))
+
+	// the received evidence was decoded, so compare it by hash
+	timer := time.After(TIMEOUT)
+	for len(reactors[1].evpool.PendingEvidence(-1)) == 0 {
+		select {
+		case <-timer:
+			t.Fatal("Timed out waiting for evidence")
+		default:
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+	received := reactors[1].evpool.PendingEvidence(-1)
+	require.Len(t, received, 1)
+	assert.True(t, ev.Equal(received[0]))
+}
+
+// makeLunaticAttackEvidence returns evidence against the first of the
+// validators signing a header at the height, which commits to the same
+// validators with another voting power.
+func makeLunaticAttackEvidence(t *testing.T, height int64,
+	privVals []types.PrivValidator) *types.LightClientAttackEvidence {
+	vals := make([]*types.Validator, len(privVals))
+	for i, privVal := range privVals {
+		vals[i] = types.NewValidator(privVal.GetPubKey(), 20)
+	}
+	valSet := types.NewValidatorSet(vals)
+	header := &types.Header{
+		ChainID:        evidenceChainID,
+		Height:         height,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+	}
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := types.NewVoteSet(evidenceChainID, height, 0, types.PrecommitType, valSet)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+
+	return &types.LightClientAttackEvidence{
+		PubKey:                privVals[0].GetPubKey(),
+		ConflictingHeader:     types.SignedHeader{Header: header, Commit: commit},
+		ConflictingValidators: valSet,
+	}
+}
+
+// mockBlockStore only holds the meta of the block at every height.
+type mockBlockStore struct {
+	blockID types.BlockID
+}
+
+var _ sm.BlockStoreRPC = mockBlockStore{}
+
+func (bs mockBlockStore) Height() int64 { return 0 }
+func (bs mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return &types.BlockMeta{BlockID: bs.blockID}
+}
+func (bs mockBlockStore) LoadBlock(height int64) *types.Block               { return nil }
+func (bs mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
+func (bs mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { return nil }
+func (bs mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
+
 func TestEvidenceListMessageValidationBasic(t *testing.T) {
 
 	testCases := []struct {
@@ -349,7 +349,7 @@ func (mem *Mempool) CheckTx(tx types.Tx, cb func(*abci.Response)) (err error) {
 	if err = mem.proxyAppConn.Error(); err != nil {
 		return err
 	}
-	reqRes := mem.proxyAppConn.CheckTxAsync(tx)
+	reqRes := mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx})
 	if cb != nil {
 		reqRes.SetCallback(cb)
 	}
@@ -622,7 +622,10 @@ func (mem *Mempool) recheckTxs(txs []types.Tx) {
 	// Push txs to proxyAppConn
 	// NOTE: resCb() may be called concurrently.
 	for _, tx := range txs {
-		mem.proxyAppConn.CheckTxAsync(tx)
+		mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{
+			Tx:   tx,
+			Type: abci.CheckTxType_Recheck,
+		})
 	}
 	mem.proxyAppConn.FlushAsync()
 }
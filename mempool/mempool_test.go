@@ -88,7 +88,7 @@ func TestReapMaxBytesMaxGas(t *testing.T) {
 	checkTxs(t, mempool, 1)
 	tx0 := mempool.TxsFront().Value.(*mempoolTx)
 	// assert that kv store has gas wanted = 1.
-	require.Equal(t, app.CheckTx(tx0.tx).GasWanted, int64(1), "KVStore had a gas value neq to 1")
+	require.Equal(t, app.CheckTx(abci.RequestCheckTx{Tx: tx0.tx}).GasWanted, int64(1), "KVStore had a gas value neq to 1")
 	require.Equal(t, tx0.gasWanted, int64(1), "transactions gas was set incorrectly")
 	// ensure each tx is 20 bytes long
 	require.Equal(t, len(tx0.tx), 20, "Tx is longer than 20 bytes")
@@ -176,6 +176,39 @@ func TestMempoolUpdateAddsTxsToCache(t *testing.T) {
 	}
 }
 
+// checkTxTypeApp records the type of the CheckTx requests it receives.
+type checkTxTypeApp struct {
+	*kvstore.KVStoreApplication
+	types []abci.CheckTxType
+}
+
+func (app *checkTxTypeApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	app.types = append(app.types, req.Type)
+	return app.KVStoreApplication.CheckTx(req)
+}
+
+func TestMempoolRecheckTxType(t *testing.T) {
+	app := &checkTxTypeApp{KVStoreApplication: kvstore.NewKVStoreApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	mempool := newMempoolWithApp(cc)
+
+	txs := checkTxs(t, mempool, 3)
+	require.Equal(t, 3, mempool.Size())
+
+	// the txs left in the mempool after the update are rechecked
+	err := mempool.Update(1, txs[:1], nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, mempool.Size())
+
+	assert.Equal(t, []abci.CheckTxType{
+		abci.CheckTxType_New,
+		abci.CheckTxType_New,
+		abci.CheckTxType_New,
+		abci.CheckTxType_Recheck,
+		abci.CheckTxType_Recheck,
+	}, app.types)
+}
+
 func TestTxsAvailable(t *testing.T) {
 	app := kvstore.NewKVStoreApplication()
 	cc := proxy.NewLocalClientCreator(app)
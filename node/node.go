@@ -8,6 +8,7 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	amino "github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	bc "github.com/tendermint/tendermint/blockchain"
+	bcv1 "github.com/tendermint/tendermint/blockchain/v1"
 	cfg "github.com/tendermint/tendermint/config"
 	cs "github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -135,6 +137,14 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 
 //------------------------------------------------------------------------------
 
+// p2pTransport is the transport of the switch, which the node listens on and
+// closes.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+}
+
 // Node is the highest level interface to a full Tendermint node.
 // It includes all configuration information and running services.
 type Node struct {
@@ -146,7 +156,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -157,11 +167,12 @@ type Node struct {
 	eventBus         *types.EventBus // pub/sub for services
 	stateDB          dbm.DB
 	blockStore       *bc.BlockStore         // store the blockchain to disk
-	bcReactor        *bc.BlockchainReactor  // for fast-syncing
+	bcReactor        p2p.Reactor            // for fast-syncing
 	mempoolReactor   *mempl.MempoolReactor  // for gossipping transactions
 	consensusState   *cs.ConsensusState     // latest consensus state
 	consensusReactor *cs.ConsensusReactor   // for participating in the consensus
 	evidencePool     *evidence.EvidencePool // tracking evidence
+	halter           *sm.Halter             // stopping at the halt height or time
 	proxyApp         proxy.AppConns         // connection to the application
 	rpcListeners     []net.Listener         // rpc servers
 	txIndexer        txindex.TxIndexer
@@ -258,7 +269,7 @@ func NewNode(config *cfg.Config,
 
 	// Decide whether to fast-sync or not
 	// We don't fast-sync when the only validator is us.
-	fastSync := config.FastSync
+	fastSync := config.FastSyncMode
 	if state.Validators.Size() == 1 {
 		addr, _ := state.Validators.GetByIndex(0)
 		privValAddr := privValidator.GetPubKey().Address()
@@ -306,12 +317,14 @@ func NewNode(config *cfg.Config,
 	}
 	evidenceLogger := logger.With("module", "evidence")
 	evidenceStore := evidence.NewEvidenceStore(evidenceDB)
-	evidencePool := evidence.NewEvidencePool(stateDB, evidenceStore)
+	evidencePool := evidence.NewEvidencePool(stateDB, blockStore, evidenceStore)
 	evidencePool.SetLogger(evidenceLogger)
 	evidenceReactor := evidence.NewEvidenceReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
 
 	blockExecLogger := logger.With("module", "state")
+	// stop committing blocks at the halt height or time
+	halter := sm.NewHalter(config.HaltHeight, config.HaltAt())
 	// make block executor for consensus and blockchain reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateDB,
@@ -319,11 +332,21 @@ func NewNode(config *cfg.Config,
 		proxyApp.Consensus(),
 		mempool,
 		evidencePool,
+		blockStore,
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithHalter(halter),
 	)
 
 	// Make BlockchainReactor
-	bcReactor := bc.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	var bcReactor p2p.Reactor
+	switch config.FastSync.Version {
+	case "v0":
+		bcReactor = bc.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	case "v1":
+		bcReactor = bcv1.NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync)
+	default:
+		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
+	}
 	bcReactor.SetLogger(logger.With("module", "blockchain"))
 
 	// Make ConsensusReactor
@@ -391,7 +414,7 @@ func NewNode(config *cfg.Config,
 	// Setup Transport.
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		transport   p2pTransport
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
@@ -440,7 +463,27 @@ func NewNode(config *cfg.Config,
 		)
 	}
 
-	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	// QUIC addresses are served by the QUIC transport, TCP and WebSocket ones
+	// by the multiplex transport.
+	laddr, err := p2p.NewNetAddressStringWithOptionalID(config.P2P.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+	if laddr.TransportProtocol() == p2p.ProtocolQUIC {
+		transport, err = p2p.NewQUICTransport(
+			nodeInfo,
+			*nodeKey,
+			mConnConfig,
+			p2p.QUICTransportConnFilters(connFilters...),
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		mt := p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		p2p.MultiplexTransportConnFilters(connFilters...)(mt)
+		transport = mt
+	}
 
 	// Setup Switch.
 	sw := p2p.NewSwitch(
@@ -450,6 +493,9 @@ func NewNode(config *cfg.Config,
 		p2p.SwitchPeerFilters(peerFilters...),
 	)
 	sw.SetLogger(p2pLogger)
+	if err := sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " ")); err != nil {
+		return nil, errors.Wrap(err, "could not add peer ids from unconditional_peer_ids field")
+	}
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
 	sw.AddReactor("CONSENSUS", consensusReactor)
@@ -483,6 +529,10 @@ func NewNode(config *cfg.Config,
 			&pex.PEXReactorConfig{
 				Seeds:    splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
 				SeedMode: config.P2P.SeedMode,
+				// Learn our external address from peers, if enabled and it's not
+				// configured.
+				DiscoverExternalAddr: config.P2P.DiscoverExternalAddress && config.P2P.ExternalAddress == "",
+				CrawlFile:            filepath.Join(filepath.Dir(config.P2P.AddrBookFile()), "crawl.json"),
 			})
 		pexReactor.SetLogger(logger.With("module", "pex"))
 		sw.AddReactor("PEX", pexReactor)
@@ -516,6 +566,7 @@ func NewNode(config *cfg.Config,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		evidencePool:     evidencePool,
+		halter:           halter,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
@@ -639,12 +690,16 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetConsensusState(n.consensusState)
 	rpccore.SetMempool(n.mempoolReactor.Mempool)
 	rpccore.SetEvidencePool(n.evidencePool)
+	rpccore.SetHalter(n.halter)
 	rpccore.SetP2PPeers(n.sw)
 	rpccore.SetP2PTransport(n)
 	pubKey := n.privValidator.GetPubKey()
 	rpccore.SetPubKey(pubKey)
 	rpccore.SetGenesisDoc(n.genesisDoc)
 	rpccore.SetAddrBook(n.addrBook)
+	if pexReactor, ok := n.sw.Reactor("PEX").(*pex.PEXReactor); ok {
+		rpccore.SetPEXReactor(pexReactor)
+	}
 	rpccore.SetProxyAppQuery(n.proxyApp.Query())
 	rpccore.SetTxIndexer(n.txIndexer)
 	rpccore.SetConsensusReactor(n.consensusReactor)
@@ -661,6 +716,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	if n.config.RPC.Unsafe {
 		rpccore.AddUnsafeRoutes()
 	}
+	if n.config.RPC.Profiling {
+		rpccore.AddProfilingRoutes()
+	}
 
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
@@ -764,6 +822,13 @@ func (n *Node) EvidencePool() *evidence.EvidencePool {
 	return n.evidencePool
 }
 
+// Halted returns a channel that's closed once the node committed the halt
+// height or time. The node's state and WAL are saved by then, so it can be
+// stopped and the process exited.
+func (n *Node) Halted() <-chan struct{} {
+	return n.halter.Halted()
+}
+
 // EventBus returns the Node's EventBus.
 func (n *Node) EventBus() *types.EventBus {
 	return n.eventBus
@@ -799,7 +864,7 @@ func (n *Node) IsListening() bool {
 
 // NodeInfo returns the Node's Info from the Switch.
 func (n *Node) NodeInfo() p2p.NodeInfo {
-	return n.nodeInfo
+	return n.sw.NodeInfo()
 }
 
 func makeNodeInfo(
@@ -832,7 +897,7 @@ func makeNodeInfo(
 	}
 
 	if config.P2P.PexReactor {
-		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
+		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel, pex.PexObservedAddrChannel)
 	}
 
 	lAddr := config.P2P.ExternalAddress
@@ -232,7 +232,7 @@ func TestCreateProposalBlock(t *testing.T) {
 	evidence.RegisterMockEvidences()
 	evidenceDB := dbm.NewMemDB()
 	evidenceStore := evidence.NewEvidenceStore(evidenceDB)
-	evidencePool := evidence.NewEvidencePool(stateDB, evidenceStore)
+	evidencePool := evidence.NewEvidencePool(stateDB, nil, evidenceStore)
 	evidencePool.SetLogger(logger)
 
 	// fill the evidence pool with more evidence
@@ -260,14 +260,17 @@ func TestCreateProposalBlock(t *testing.T) {
 		proxyApp.Consensus(),
 		mempool,
 		evidencePool,
+		nil,
 	)
 
 	commit := &types.Commit{}
-	block, _ := blockExec.CreateProposalBlock(
+	block, _, err := blockExec.CreateProposalBlock(
 		height,
 		state, commit,
 		proposerAddr,
+		tmtime.Now(),
 	)
+	require.NoError(t, err)
 
 	err = blockExec.ValidateBlock(state, block)
 	assert.NoError(t, err)
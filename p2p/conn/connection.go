@@ -466,12 +466,17 @@ func (c *MConnection) sendPacketMsg() bool {
 	// Choose a channel to create a PacketMsg from.
 	// The chosen channel will be the one whose recentlySent/priority is the least.
 	var leastRatio float32 = math.MaxFloat32
-	var leastChannel *Channel
+	var leastChannel, throttledChannel *Channel
 	for _, channel := range c.channels {
 		// If nothing to send, skip this channel
 		if !channel.isSendPending() {
 			continue
 		}
+		// If the channel used up its own send rate, skip it for now
+		if channel.isThrottled() {
+			throttledChannel = channel
+			continue
+		}
 		// Get ratio, and keep track of lowest ratio.
 		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
 		if ratio < leastRatio {
@@ -482,7 +487,13 @@ func (c *MConnection) sendPacketMsg() bool {
 
 	// Nothing to send?
 	if leastChannel == nil {
-		return true
+		if throttledChannel == nil {
+			return true
+		}
+		// Only throttled channels have something to send, block until one of
+		// them may send again rather than spinning.
+		throttledChannel.sendMonitor.Limit(c._maxPacketMsgSize, throttledChannel.desc.SendRate, true)
+		return false
 	}
 	// c.Logger.Info("Found a msgPacket to send")
 
@@ -565,6 +576,7 @@ FOR_LOOP:
 				break FOR_LOOP
 			}
 
+			channel.recvMonitor.Update(int(_n))
 			msgBytes, err := channel.recvPacketMsg(pkt)
 			if err != nil {
 				if c.IsRunning() {
@@ -622,8 +634,12 @@ type ChannelStatus struct {
 	ID                byte
 	SendQueueCapacity int
 	SendQueueSize     int
+	SendQueueBytes    int64 // bytes queued or partially sent
 	Priority          int
 	RecentlySent      int64
+	SendRate          int64 // 0 if only limited by the connection
+	SendMonitor       flow.Status
+	RecvMonitor       flow.Status
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -637,8 +653,12 @@ func (c *MConnection) Status() ConnectionStatus {
 			ID:                channel.desc.ID,
 			SendQueueCapacity: cap(channel.sendQueue),
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
+			SendQueueBytes:    atomic.LoadInt64(&channel.sendQueueBytes),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			SendRate:          channel.desc.SendRate,
+			SendMonitor:       channel.sendMonitor.Status(),
+			RecvMonitor:       channel.recvMonitor.Status(),
 		}
 	}
 	return status
@@ -652,6 +672,12 @@ type ChannelDescriptor struct {
 	SendQueueCapacity   int
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
+
+	// SendRate caps the bytes per second sent on this channel, on top of the
+	// connection-wide MConnConfig.SendRate. 0 means no per-channel limit.
+	// Since whole packets are sent, the cap is only approximate for rates
+	// below MaxPacketMsgPayloadSize per 100ms.
+	SendRate int64
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
@@ -679,6 +705,10 @@ type Channel struct {
 	sending       []byte
 	recentlySent  int64 // exponential moving average
 
+	sendQueueBytes int64 // atomic.
+	sendMonitor    *flow.Monitor
+	recvMonitor    *flow.Monitor
+
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
@@ -694,6 +724,8 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -709,6 +741,7 @@ func (ch *Channel) sendBytes(bytes []byte) bool {
 	select {
 	case ch.sendQueue <- bytes:
 		atomic.AddInt32(&ch.sendQueueSize, 1)
+		atomic.AddInt64(&ch.sendQueueBytes, int64(len(bytes)))
 		return true
 	case <-time.After(defaultSendTimeout):
 		return false
@@ -722,6 +755,7 @@ func (ch *Channel) trySendBytes(bytes []byte) bool {
 	select {
 	case ch.sendQueue <- bytes:
 		atomic.AddInt32(&ch.sendQueueSize, 1)
+		atomic.AddInt64(&ch.sendQueueBytes, int64(len(bytes)))
 		return true
 	default:
 		return false
@@ -759,6 +793,7 @@ func (ch *Channel) nextPacketMsg() PacketMsg {
 	packet.ChannelID = byte(ch.desc.ID)
 	maxSize := ch.maxPacketMsgPayloadSize
 	packet.Bytes = ch.sending[:cmn.MinInt(maxSize, len(ch.sending))]
+	atomic.AddInt64(&ch.sendQueueBytes, -int64(len(packet.Bytes)))
 	if len(ch.sending) <= maxSize {
 		packet.EOF = byte(0x01)
 		ch.sending = nil
@@ -770,12 +805,19 @@ func (ch *Channel) nextPacketMsg() PacketMsg {
 	return packet
 }
 
+// Returns true if the channel has used up its SendRate for now.
+// Goroutine-safe
+func (ch *Channel) isThrottled() bool {
+	return ch.desc.SendRate > 0 && ch.sendMonitor.Limit(1, ch.desc.SendRate, false) == 0
+}
+
 // Writes next PacketMsg to w and updates c.recentlySent.
 // Not goroutine-safe
 func (ch *Channel) writePacketMsgTo(w io.Writer) (n int64, err error) {
 	var packet = ch.nextPacketMsg()
 	n, err = cdc.MarshalBinaryLengthPrefixedWriter(w, packet)
 	atomic.AddInt64(&ch.recentlySent, n)
+	ch.sendMonitor.Update(int(n))
 	return
 }
 
@@ -155,6 +155,119 @@ func TestMConnectionStatus(t *testing.T) {
 	assert.Zero(t, status.Channels[0].SendQueueSize)
 }
 
+func TestMConnectionChannelStatus(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close() // nolint: errcheck
+	defer client.Close() // nolint: errcheck
+
+	receivedCh := make(chan []byte)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	onError := func(r interface{}) {}
+	mconn1 := createMConnectionWithCallbacks(client, onReceive, onError)
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop()
+
+	mconn2 := createTestMConnection(server)
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop()
+
+	msg := []byte("Hawkeye")
+	assert.True(t, mconn2.Send(0x01, msg))
+	select {
+	case <-receivedCh:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("Did not receive %s message in 500ms", msg)
+	}
+
+	assert.Zero(t, mconn2.Status().Channels[0].SendQueueBytes)
+	// monitors only count the bytes of a sample once it's complete
+	waitForChannelStatus(t, mconn2, func(s ChannelStatus) bool {
+		return s.SendMonitor.Bytes > int64(len(msg))
+	})
+	waitForChannelStatus(t, mconn1, func(s ChannelStatus) bool {
+		return s.RecvMonitor.Bytes > int64(len(msg))
+	})
+}
+
+// waitForChannelStatus polls the status of the first channel of mconn until
+// cond holds, failing the test if it doesn't within a second.
+func waitForChannelStatus(t *testing.T, mconn *MConnection, cond func(ChannelStatus) bool) {
+	timeout := time.After(time.Second)
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		status := mconn.Status().Channels[0]
+		if cond(status) {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			t.Fatalf("Channel status did not reach the expected state: %+v", status)
+		}
+	}
+}
+
+func TestMConnectionChannelSendRate(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close() // nolint: errcheck
+	defer client.Close() // nolint: errcheck
+
+	const numMsgs = 5
+	chDescs := []*ChannelDescriptor{
+		// about one 1KB packet per 100ms
+		{ID: 0x01, Priority: 10, SendQueueCapacity: numMsgs, SendRate: 10000},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 1},
+	}
+	onError := func(r interface{}) {}
+
+	mconnSender := NewMConnection(client, chDescs, func(chID byte, msgBytes []byte) {}, onError)
+	mconnSender.SetLogger(log.TestingLogger().With("module", "sender"))
+
+	receivedCh := make(chan byte, numMsgs+1)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- chID
+	}
+	mconnReceiver := NewMConnection(server, chDescs, onReceive, onError)
+	mconnReceiver.SetLogger(log.TestingLogger().With("module", "receiver"))
+	require.Nil(t, mconnReceiver.Start())
+	defer mconnReceiver.Stop()
+
+	// queue directly on the channel, since TrySend refuses messages before
+	// the connection is started and nothing may be sent before we check
+	msg := make([]byte, 1000)
+	for i := 0; i < numMsgs; i++ {
+		require.True(t, mconnSender.channelsIdx[0x01].trySendBytes(msg))
+	}
+	assert.EqualValues(t, numMsgs*len(msg), mconnSender.Status().Channels[0].SendQueueBytes)
+
+	start := time.Now()
+	require.Nil(t, mconnSender.Start())
+	defer mconnSender.Stop()
+	// also wakes up the send routine for the messages queued above
+	require.True(t, mconnSender.TrySend(0x02, msg))
+
+	var order []byte
+	for len(order) < numMsgs+1 {
+		select {
+		case chID := <-receivedCh:
+			order = append(order, chID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Received only %d messages", len(order))
+		}
+	}
+
+	// the throttled channel has the higher priority, but doesn't hold back
+	// the other one
+	assert.NotEqual(t, byte(0x02), order[len(order)-1])
+	assert.True(t, time.Since(start) >= 300*time.Millisecond, "channel send rate was not enforced")
+	assert.Zero(t, mconnSender.Status().Channels[0].SendQueueBytes)
+}
+
 func TestMConnectionPongTimeoutResultsInError(t *testing.T) {
 	server, client := net.Pipe()
 	defer server.Close()
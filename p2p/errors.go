@@ -145,6 +145,14 @@ func (e ErrTransportClosed) Error() string {
 	return "transport has been closed"
 }
 
+// ErrQUICUnsupported is raised when creating a QUICTransport in a build
+// without the quic tag.
+type ErrQUICUnsupported struct{}
+
+func (e ErrQUICUnsupported) Error() string {
+	return "QUIC transport not supported: build with -tags quic"
+}
+
 //-------------------------------------------------------------------
 
 type ErrNetAddressNoID struct {
@@ -18,6 +18,31 @@ type ID string
 // TODO: support other length addresses ?
 const IDByteLength = crypto.AddressSize
 
+// ParseID returns the ID for a hex-encoded crypto.Address.
+func ParseID(id string) (ID, error) {
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer ID %q: %v", id, err)
+	}
+	if len(idBytes) != IDByteLength {
+		return "", fmt.Errorf("invalid peer ID length - got %d, expected %d", len(idBytes), IDByteLength)
+	}
+	return ID(id), nil
+}
+
+// ParseIDs parses all of the given IDs, failing on the first invalid one.
+func ParseIDs(ids []string) ([]ID, error) {
+	parsed := make([]ID, 0, len(ids))
+	for _, id := range ids {
+		p, err := ParseID(id)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, p)
+	}
+	return parsed, nil
+}
+
 //------------------------------------------------------------------------------
 // Persistent peer ID
 // TODO: encrypt on disk
@@ -22,6 +22,19 @@ func TestLoadOrGenNodeKey(t *testing.T) {
 	assert.Equal(t, nodeKey, nodeKey2)
 }
 
+func TestParseIDs(t *testing.T) {
+	id := "f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"
+
+	ids, err := ParseIDs([]string{id})
+	assert.Nil(t, err)
+	assert.Equal(t, []ID{ID(id)}, ids)
+
+	for _, invalid := range []string{"", "invalid", id[:38], id + "00"} {
+		_, err = ParseIDs([]string{id, invalid})
+		assert.NotNil(t, err, invalid)
+	}
+}
+
 //----------------------------------------------------------
 
 func padBytes(bz []byte, targetBytes int) []byte {
@@ -17,12 +17,14 @@ const (
 type Metrics struct {
 	// Number of peers.
 	Peers metrics.Gauge
-	// Number of bytes received from a given peer.
+	// Number of bytes received from a given peer, per channel.
 	PeerReceiveBytesTotal metrics.Counter
-	// Number of bytes sent to a given peer.
+	// Number of bytes sent to a given peer, per channel.
 	PeerSendBytesTotal metrics.Counter
-	// Pending bytes to be sent to a given peer.
+	// Pending bytes to be sent to a given peer, per channel.
 	PeerPendingSendBytes metrics.Gauge
+	// Number of messages queued to be sent to a given peer, per channel.
+	PeerSendQueueSize metrics.Gauge
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge
 }
@@ -46,20 +48,26 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "peer_receive_bytes_total",
-			Help:      "Number of bytes received from a given peer.",
-		}, append(labels, "peer_id")).With(labelsAndValues...),
+			Help:      "Number of bytes received from a given peer, per channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		PeerSendBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "peer_send_bytes_total",
-			Help:      "Number of bytes sent to a given peer.",
-		}, append(labels, "peer_id")).With(labelsAndValues...),
+			Help:      "Number of bytes sent to a given peer, per channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		PeerPendingSendBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "peer_pending_send_bytes",
-			Help:      "Number of pending bytes to be sent to a given peer.",
-		}, append(labels, "peer_id")).With(labelsAndValues...),
+			Help:      "Number of pending bytes to be sent to a given peer, per channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendQueueSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_queue_size",
+			Help:      "Number of messages queued to be sent to a given peer, per channel.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		NumTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -76,6 +84,7 @@ func NopMetrics() *Metrics {
 		PeerReceiveBytesTotal: discard.NewCounter(),
 		PeerSendBytesTotal:    discard.NewCounter(),
 		PeerPendingSendBytes:  discard.NewGauge(),
+		PeerSendQueueSize:     discard.NewGauge(),
 		NumTxs:                discard.NewGauge(),
 	}
 }
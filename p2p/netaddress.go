@@ -18,46 +18,66 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
+// Protocols the p2p transport can listen on and dial, given as the scheme of
+// an address ("ws://<ID>@<IP>:<PORT>"). Addresses without a scheme, or with
+// any other scheme, use TCP. TCP and WebSocket addresses are served by the
+// MultiplexTransport, QUIC addresses by the QUICTransport.
+const (
+	ProtocolTCP       = "tcp"
+	ProtocolWebSocket = "ws"
+	ProtocolQUIC      = "quic"
+)
+
 // NetAddress defines information about a peer on the network
-// including its ID, IP address, and port.
+// including its ID, IP address, port, and protocol.
 type NetAddress struct {
 	ID   ID     `json:"id"`
 	IP   net.IP `json:"ip"`
 	Port uint16 `json:"port"`
 
-	// TODO:
-	// Name string `json:"name"` // optional DNS name
+	// Protocol is empty for TCP, the default, so TCP addresses are encoded
+	// the same as before protocols were introduced.
+	Protocol string `json:"protocol,omitempty"`
+
+	// DNS name the IP was resolved from, if any. It is not sent over the
+	// wire, only used to re-resolve the address (see Resolve).
+	host string
 
 	// memoize .String()
 	str string
 }
 
-// IDAddressString returns id@hostPort. It strips the leading
-// protocol from protocolHostPort if it exists.
+// IDAddressString returns id@hostPort, prefixed with the protocol of
+// protocolHostPort unless it's TCP.
 func IDAddressString(id ID, protocolHostPort string) string {
-	hostPort := removeProtocolIfDefined(protocolHostPort)
-	return fmt.Sprintf("%s@%s", id, hostPort)
+	protocol, hostPort := splitProtocol(protocolHostPort)
+	return withProtocol(protocol, fmt.Sprintf("%s@%s", id, hostPort))
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
-// panic.
+// NewNetAddress returns a new NetAddress using the provided TCP address, or
+// UDP address for QUIC connections. When testing, other net.Addr will result
+// in using 0.0.0.0:0. When normal run, other net.Addr will panic.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip   net.IP
+		port int
+	)
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = addr.IP, addr.Port
+	case *net.UDPAddr:
+		ip, port = addr.IP, addr.Port
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			cmn.PanicSanity(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			cmn.PanicSanity(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		} else { // in testing
 			netAddr := NewNetAddressIPPort(net.IP("0.0.0.0"), 0)
 			netAddr.ID = id
 			return netAddr
 		}
 	}
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
-	na := NewNetAddressIPPort(ip, port)
+	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
 	return na
 }
@@ -78,7 +98,7 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 // provided address in the form of "ID@IP:Port", where the ID is optional.
 // Also resolves the host if host is not an IP.
 func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
-	addrWithoutProtocol := removeProtocolIfDefined(addr)
+	protocol, addrWithoutProtocol := splitProtocol(addr)
 
 	var id ID
 	spl := strings.Split(addrWithoutProtocol, "@")
@@ -107,13 +127,14 @@ func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
 			errors.New("host is empty")}
 	}
 
+	var hostname string
 	ip := net.ParseIP(host)
 	if ip == nil {
-		ips, err := net.LookupIP(host)
+		ip, err = lookupIP(host)
 		if err != nil {
-			return nil, ErrNetAddressLookup{host, err}
+			return nil, err
 		}
-		ip = ips[0]
+		hostname = host
 	}
 
 	port, err := strconv.ParseUint(portStr, 10, 16)
@@ -123,9 +144,19 @@ func NewNetAddressStringWithOptionalID(addr string) (*NetAddress, error) {
 
 	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
+	na.Protocol = protocol
+	na.host = hostname
 	return na, nil
 }
 
+func lookupIP(host string) (net.IP, error) {
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, ErrNetAddressLookup{host, err}
+	}
+	return ips[0], nil
+}
+
 // NewNetAddressStrings returns an array of NetAddress'es build using
 // the provided strings.
 func NewNetAddressStrings(addrs []string) ([]*NetAddress, []error) {
@@ -151,8 +182,35 @@ func NewNetAddressIPPort(ip net.IP, port uint16) *NetAddress {
 	}
 }
 
+// Hostname returns the DNS name the address was resolved from, or an empty
+// string if it was given as an IP.
+func (na *NetAddress) Hostname() string {
+	return na.host
+}
+
+// Resolve looks up the hostname of the address again and returns a copy of
+// the address with the current IP. Addresses given as an IP are returned
+// as is.
+func (na *NetAddress) Resolve() (*NetAddress, error) {
+	if na.host == "" {
+		return na, nil
+	}
+	ip, err := lookupIP(na.host)
+	if err != nil {
+		return nil, err
+	}
+	if ip.Equal(na.IP) {
+		return na, nil
+	}
+	resolved := NewNetAddressIPPort(ip, na.Port)
+	resolved.ID = na.ID
+	resolved.Protocol = na.Protocol
+	resolved.host = na.host
+	return resolved, nil
+}
+
 // Equals reports whether na and other are the same addresses,
-// including their ID, IP, and Port.
+// including their ID, IP, Port, and Protocol.
 func (na *NetAddress) Equals(other interface{}) bool {
 	if o, ok := other.(*NetAddress); ok {
 		return na.String() == o.String()
@@ -173,7 +231,7 @@ func (na *NetAddress) Same(other interface{}) bool {
 	return false
 }
 
-// String representation: <ID>@<IP>:<PORT>
+// String representation: [<PROTOCOL>://]<ID>@<IP>:<PORT>
 func (na *NetAddress) String() string {
 	if na == nil {
 		return "<nil-NetAddress>"
@@ -181,13 +239,28 @@ func (na *NetAddress) String() string {
 	if na.str == "" {
 		addrStr := na.DialString()
 		if na.ID != "" {
-			addrStr = IDAddressString(na.ID, addrStr)
+			addrStr = fmt.Sprintf("%s@%s", na.ID, addrStr)
 		}
-		na.str = addrStr
+		na.str = withProtocol(na.Protocol, addrStr)
 	}
 	return na.str
 }
 
+// ListenString returns the address without ID in the form accepted by
+// ListenAddr: [<PROTOCOL>://]<IP>:<PORT>
+func (na *NetAddress) ListenString() string {
+	return withProtocol(na.Protocol, na.DialString())
+}
+
+// TransportProtocol returns the protocol used to dial the address, ProtocolTCP
+// if none is set.
+func (na *NetAddress) TransportProtocol() string {
+	if na.Protocol == "" {
+		return ProtocolTCP
+	}
+	return na.Protocol
+}
+
 func (na *NetAddress) DialString() string {
 	if na == nil {
 		return "<nil-NetAddress>"
@@ -333,10 +406,24 @@ func (na *NetAddress) RFC4862() bool { return rfc4862.Contains(na.IP) }
 func (na *NetAddress) RFC6052() bool { return rfc6052.Contains(na.IP) }
 func (na *NetAddress) RFC6145() bool { return rfc6145.Contains(na.IP) }
 
-func removeProtocolIfDefined(addr string) string {
-	if strings.Contains(addr, "://") {
-		return strings.Split(addr, "://")[1]
+// splitProtocol splits the leading protocol off addr, if it's defined.
+// Protocols other than ProtocolWebSocket and ProtocolQUIC are dropped, so the
+// address is dialed over TCP.
+func splitProtocol(addr string) (protocol string, addrWithoutProtocol string) {
+	if !strings.Contains(addr, "://") {
+		return "", addr
 	}
-	return addr
+	spl := strings.SplitN(addr, "://", 2)
+	switch spl[0] {
+	case ProtocolWebSocket, ProtocolQUIC:
+		protocol = spl[0]
+	}
+	return protocol, spl[1]
+}
 
+func withProtocol(protocol, addr string) string {
+	if protocol == "" {
+		return addr
+	}
+	return protocol + "://" + addr
 }
@@ -48,6 +48,10 @@ func TestNewNetAddressStringWithOptionalID(t *testing.T) {
 		{"notHex nodeId w/tcp", "tcp://xxxxbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 		{"correct nodeId w/tcp", "tcp://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", true},
 
+		{"no node id, ws input", "ws://127.0.0.1:8080", "ws://127.0.0.1:8080", true},
+		{"too short nodeId w/ws", "ws://deadbeef@127.0.0.1:8080", "", false},
+		{"correct nodeId w/ws", "ws://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "ws://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", true},
+
 		{"no node id when expected", "tcp://@127.0.0.1:8080", "", false},
 		{"no node id or IP", "tcp://@", "", false},
 		{"tcp no host, w/ port", "tcp://:26656", "", false},
@@ -71,6 +75,34 @@ func TestNewNetAddressStringWithOptionalID(t *testing.T) {
 	}
 }
 
+func TestNetAddressProtocol(t *testing.T) {
+	id := ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	assert.Equal(t, string(id)+"@127.0.0.1:8080", IDAddressString(id, "tcp://127.0.0.1:8080"))
+	assert.Equal(t, "ws://"+string(id)+"@127.0.0.1:8080", IDAddressString(id, "ws://127.0.0.1:8080"))
+
+	addr, err := NewNetAddressString(IDAddressString(id, "ws://127.0.0.1:8080"))
+	require.Nil(t, err)
+	assert.Equal(t, ProtocolWebSocket, addr.Protocol)
+	assert.Equal(t, ProtocolWebSocket, addr.TransportProtocol())
+	assert.Equal(t, "127.0.0.1:8080", addr.DialString())
+	assert.Equal(t, "ws://127.0.0.1:8080", addr.ListenString())
+
+	tcpAddr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:8080"))
+	require.Nil(t, err)
+	assert.Empty(t, tcpAddr.Protocol)
+	assert.Equal(t, ProtocolTCP, tcpAddr.TransportProtocol())
+	assert.False(t, addr.Equals(tcpAddr))
+	assert.True(t, addr.Same(tcpAddr))
+
+	quicAddr, err := NewNetAddressString(IDAddressString(id, "quic://127.0.0.1:8080"))
+	require.Nil(t, err)
+	assert.Equal(t, ProtocolQUIC, quicAddr.TransportProtocol())
+	assert.Equal(t, "quic://"+string(id)+"@127.0.0.1:8080", quicAddr.String())
+
+	udpAddr := NewNetAddress(id, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8080})
+	assert.Equal(t, "127.0.0.1:8080", udpAddr.DialString())
+}
+
 func TestNewNetAddressString(t *testing.T) {
 	testCases := []struct {
 		addr     string
@@ -107,6 +139,34 @@ func TestNewNetAddressIPPort(t *testing.T) {
 	assert.Equal(t, "127.0.0.1:8080", addr.String())
 }
 
+func TestNetAddressResolve(t *testing.T) {
+	addr, err := NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@localhost:8080")
+	require.Nil(t, err)
+	assert.Equal(t, "localhost", addr.Hostname())
+
+	resolved, err := addr.Resolve()
+	require.Nil(t, err)
+	assert.Equal(t, addr.ID, resolved.ID)
+	assert.Equal(t, addr.Port, resolved.Port)
+	assert.Equal(t, "localhost", resolved.Hostname())
+	assert.True(t, resolved.IP.IsLoopback())
+
+	// a stale IP is replaced with the current one
+	addr.IP = net.ParseIP("192.0.2.1")
+	resolved, err = addr.Resolve()
+	require.Nil(t, err)
+	assert.True(t, resolved.IP.IsLoopback())
+	assert.Equal(t, "192.0.2.1", addr.IP.String(), "original address is not modified")
+
+	// addresses given as an IP are not resolved
+	addr, err = NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080")
+	require.Nil(t, err)
+	assert.Empty(t, addr.Hostname())
+	resolved, err = addr.Resolve()
+	require.Nil(t, err)
+	assert.True(t, addr == resolved)
+}
+
 func TestNetAddressProperties(t *testing.T) {
 	// TODO add more test cases
 	testCases := []struct {
@@ -249,7 +249,7 @@ func (p *peer) Send(chID byte, msgBytes []byte) bool {
 	}
 	res := p.mconn.Send(chID, msgBytes)
 	if res {
-		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
+		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
 	}
 	return res
 }
@@ -264,7 +264,7 @@ func (p *peer) TrySend(chID byte, msgBytes []byte) bool {
 	}
 	res := p.mconn.TrySend(chID, msgBytes)
 	if res {
-		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
+		p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
 	}
 	return res
 }
@@ -339,12 +339,11 @@ func (p *peer) metricsReporter() {
 		select {
 		case <-p.metricsTicker.C:
 			status := p.mconn.Status()
-			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
-				sendQueueSize += float64(chStatus.SendQueueSize)
+				labels := []string{"peer_id", string(p.ID()), "chID", chIDLabel(chStatus.ID)}
+				p.metrics.PeerPendingSendBytes.With(labels...).Set(float64(chStatus.SendQueueBytes))
+				p.metrics.PeerSendQueueSize.With(labels...).Set(float64(chStatus.SendQueueSize))
 			}
-
-			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
 		case <-p.Quit():
 			return
 		}
@@ -354,6 +353,11 @@ func (p *peer) metricsReporter() {
 //------------------------------------------------------------------
 // helper funcs
 
+// chIDLabel formats a channel ID as a metrics label value.
+func chIDLabel(chID byte) string {
+	return fmt.Sprintf("%#x", chID)
+}
+
 func createMConnection(
 	conn net.Conn,
 	p *peer,
@@ -370,7 +374,7 @@ func createMConnection(
 			// which does onPeerError.
 			panic(fmt.Sprintf("Unknown channel %X", chID))
 		}
-		p.metrics.PeerReceiveBytesTotal.With("peer_id", string(p.ID())).Add(float64(len(msgBytes)))
+		p.metrics.PeerReceiveBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
 		reactor.Receive(chID, p, msgBytes)
 	}
 
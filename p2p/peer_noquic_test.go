@@ -0,0 +1,33 @@
+// +build !quic
+
+package p2p
+
+import (
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+)
+
+// Without the quic tag, ProtocolQUIC isn't among the testProtocols, so the
+// QUIC helpers are never called.
+
+func setTestPeerPersistent(p Peer) {
+	if p, ok := p.(*peer); ok {
+		p.persistent = true
+	}
+}
+
+func createOutboundQUICPeerAndPerformHandshake(
+	addr *NetAddress,
+	config *config.P2PConfig,
+	pk crypto.PrivKey,
+	ourNodeInfo NodeInfo,
+	chDescs []*tmconn.ChannelDescriptor,
+	reactorsByCh map[byte]Reactor,
+) (Peer, error) {
+	return nil, ErrQUICUnsupported{}
+}
+
+func newQUICTestNetwork(privKey crypto.PrivKey) TransportNetwork {
+	panic(ErrQUICUnsupported{})
+}
@@ -0,0 +1,501 @@
+// +build quic
+
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	quic "github.com/quic-go/quic-go"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+)
+
+// quicSendTimeout is how long Send waits for room in a send queue.
+const quicSendTimeout = 10 * time.Second
+
+// quicPeer implements Peer over a QUIC connection. Instead of multiplexing all
+// channels over a single connection, as an MConnection does, each channel
+// sends its messages on a unidirectional QUIC stream of its own, so a channel
+// busy with large messages doesn't hold back the others.
+//
+// A stream starts with the ID of its channel, followed by the messages, each
+// prefixed with its length as an uvarint. Streams are opened on the first
+// message of their channel. The peer closing the control stream, which NodeInfo
+// was exchanged over, closes the connection.
+//
+// The streams are read concurrently, but their messages are passed to the
+// reactors one at a time by a single routine, as by an MConnection, so a
+// reactor never receives from the same peer concurrently.
+type quicPeer struct {
+	cmn.BaseService
+
+	conn         *quicConn
+	outbound     bool
+	persistent   bool
+	originalAddr *NetAddress // nil for inbound connections
+
+	nodeInfo NodeInfo
+	channels []byte
+
+	chDescs      map[byte]*tmconn.ChannelDescriptor
+	sendQueues   map[byte]chan []byte
+	recvc        chan quicMessage // messages of all streams, see recvStream
+	reactorsByCh map[byte]Reactor
+	onPeerError  func(Peer, interface{})
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	flushc   chan struct{} // closed by FlushStop to drain the send queues
+	sendWg   sync.WaitGroup
+	stopOnce sync.Once
+
+	// User data
+	Data *cmn.CMap
+
+	metrics       *Metrics
+	metricsTicker *time.Ticker
+}
+
+var _ Peer = (*quicPeer)(nil)
+
+// quicMessage is a message received on the stream of a channel.
+type quicMessage struct {
+	chID     byte
+	msgBytes []byte
+}
+
+func newQUICPeer(
+	c *quicConn,
+	nodeInfo NodeInfo,
+	cfg peerConfig,
+	originalAddr *NetAddress,
+) *quicPeer {
+	metrics := cfg.metrics
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+
+	p := &quicPeer{
+		conn:          c,
+		outbound:      cfg.outbound,
+		persistent:    cfg.persistent,
+		originalAddr:  originalAddr,
+		nodeInfo:      nodeInfo,
+		channels:      nodeInfo.(DefaultNodeInfo).Channels, // TODO
+		chDescs:       make(map[byte]*tmconn.ChannelDescriptor, len(cfg.chDescs)),
+		sendQueues:    make(map[byte]chan []byte, len(cfg.chDescs)),
+		recvc:         make(chan quicMessage),
+		reactorsByCh:  cfg.reactorsByCh,
+		onPeerError:   cfg.onPeerError,
+		flushc:        make(chan struct{}),
+		Data:          cmn.NewCMap(),
+		metrics:       metrics,
+		metricsTicker: time.NewTicker(metricsTickerDuration),
+	}
+	for _, desc := range cfg.chDescs {
+		d := desc.FillDefaults()
+		p.chDescs[d.ID] = &d
+		p.sendQueues[d.ID] = make(chan []byte, d.SendQueueCapacity)
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.BaseService = *cmn.NewBaseService(nil, "Peer", p)
+
+	return p
+}
+
+// String representation.
+func (p *quicPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{QUIC %v %v out}", p.RemoteAddr(), p.ID())
+	}
+
+	return fmt.Sprintf("Peer{QUIC %v %v in}", p.RemoteAddr(), p.ID())
+}
+
+//---------------------------------------------------
+// Implements cmn.Service
+
+// OnStart implements BaseService.
+func (p *quicPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	for chID, queue := range p.sendQueues {
+		p.sendWg.Add(1)
+		go p.sendRoutine(chID, queue)
+	}
+	go p.recvRoutine()
+	go p.deliverRoutine()
+	go p.controlRoutine()
+	go p.metricsReporter()
+	return nil
+}
+
+// FlushStop mimics OnStop but additionally ensures that all successful
+// .Send() calls will get written to their streams before closing the
+// connection, which leaves the peer time to read them.
+// NOTE: it is not safe to call this method more than once.
+func (p *quicPeer) FlushStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+
+	close(p.flushc)
+	p.sendWg.Wait()
+
+	p.cancel()
+	_ = p.conn.Close()
+}
+
+// OnStop implements BaseService.
+func (p *quicPeer) OnStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	p.cancel()
+	_ = p.conn.Close()
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *quicPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// RemoteIP returns the IP of the connection.
+func (p *quicPeer) RemoteIP() net.IP {
+	return p.conn.RemoteAddr().(*net.UDPAddr).IP
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *quicPeer) RemoteAddr() net.Addr {
+	return p.conn.RemoteAddr()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *quicPeer) IsOutbound() bool {
+	return p.outbound
+}
+
+// IsPersistent returns true if the peer is persitent, false otherwise.
+func (p *quicPeer) IsPersistent() bool {
+	return p.persistent
+}
+
+// CloseConn closes the connection. Used for cleaning up in cases where the
+// peer had not been started at all.
+func (p *quicPeer) CloseConn() error {
+	return p.conn.Close()
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *quicPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// Status returns the sizes of the send queues. QUIC keeps the flow statistics
+// of its streams to itself, so the monitors are left empty.
+func (p *quicPeer) Status() tmconn.ConnectionStatus {
+	var status tmconn.ConnectionStatus
+	status.Channels = make([]tmconn.ChannelStatus, 0, len(p.chDescs))
+	for chID, desc := range p.chDescs {
+		status.Channels = append(status.Channels, tmconn.ChannelStatus{
+			ID:                chID,
+			SendQueueCapacity: cap(p.sendQueues[chID]),
+			SendQueueSize:     len(p.sendQueues[chID]),
+			Priority:          desc.Priority,
+		})
+	}
+	return status
+}
+
+// OriginalAddr returns the original address, which was used to connect with
+// the peer. Returns nil for inbound peers.
+func (p *quicPeer) OriginalAddr() *NetAddress {
+	if p.outbound {
+		return p.originalAddr
+	}
+	return nil
+}
+
+// Send queues msg bytes for the stream of the channel identified by chID
+// byte. Returns false if the send queue is still full after a timeout.
+func (p *quicPeer) Send(chID byte, msgBytes []byte) bool {
+	queue, ok := p.sendQueue(chID)
+	if !ok {
+		return false
+	}
+
+	select {
+	case queue <- msgBytes:
+	case <-p.ctx.Done():
+		return false
+	case <-time.After(quicSendTimeout):
+		return false
+	}
+	p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
+	return true
+}
+
+// TrySend queues msg bytes for the stream of the channel identified by chID
+// byte. Immediately returns false if the send queue is full.
+func (p *quicPeer) TrySend(chID byte, msgBytes []byte) bool {
+	queue, ok := p.sendQueue(chID)
+	if !ok {
+		return false
+	}
+
+	select {
+	case queue <- msgBytes:
+	default:
+		return false
+	}
+	p.metrics.PeerSendBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
+	return true
+}
+
+// Get the data for a given key.
+func (p *quicPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *quicPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+//---------------------------------------------------
+// methods only used for testing
+
+// CanSend returns true if the send queue is not full, false otherwise.
+func (p *quicPeer) CanSend(chID byte) bool {
+	if !p.IsRunning() {
+		return false
+	}
+	queue, ok := p.sendQueues[chID]
+	return ok && len(queue) < cap(queue)
+}
+
+//---------------------------------------------------
+
+// sendQueue returns the send queue of the channel, if the peer is running and
+// both we and the peer know about the channel.
+func (p *quicPeer) sendQueue(chID byte) (chan []byte, bool) {
+	if !p.IsRunning() {
+		// see Switch#Broadcast, where we fetch the list of peers and loop over
+		// them - while we're looping, one peer may be removed and stopped.
+		return nil, false
+	} else if !p.hasChannel(chID) {
+		return nil, false
+	}
+	queue, ok := p.sendQueues[chID]
+	if !ok {
+		p.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+	}
+	return queue, ok
+}
+
+// hasChannel returns true if the peer reported
+// knowing about the given chID.
+func (p *quicPeer) hasChannel(chID byte) bool {
+	for _, ch := range p.channels {
+		if ch == chID {
+			return true
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return false
+}
+
+// sendRoutine writes the messages queued for the channel to its stream, which
+// is opened on the first message. Once FlushStop was called, it writes the
+// messages still queued and closes the stream.
+func (p *quicPeer) sendRoutine(chID byte, queue chan []byte) {
+	defer p.sendWg.Done()
+
+	var (
+		stream *quic.SendStream
+		w      *bufio.Writer
+		lenBuf [binary.MaxVarintLen64]byte
+	)
+	write := func(msgBytes []byte) error {
+		if stream == nil {
+			var err error
+			if stream, err = p.conn.conn.OpenUniStreamSync(p.ctx); err != nil {
+				return err
+			}
+			w = bufio.NewWriter(stream)
+			if err := w.WriteByte(chID); err != nil {
+				return err
+			}
+		}
+		n := binary.PutUvarint(lenBuf[:], uint64(len(msgBytes)))
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(msgBytes); err != nil {
+			return err
+		}
+		// Write out messages in a row together.
+		if len(queue) == 0 {
+			return w.Flush()
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case msgBytes := <-queue:
+			if err := write(msgBytes); err != nil {
+				p.stopForError(err)
+				return
+			}
+		case <-p.flushc:
+			for {
+				select {
+				case msgBytes := <-queue:
+					if err := write(msgBytes); err != nil {
+						return
+					}
+				default:
+					if stream != nil {
+						_ = w.Flush()
+						_ = stream.Close()
+					}
+					return
+				}
+			}
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// recvRoutine accepts the streams of the peer's channels until the connection
+// is closed.
+func (p *quicPeer) recvRoutine() {
+	for {
+		stream, err := p.conn.conn.AcceptUniStream(p.conn.ctx)
+		if err != nil {
+			p.stopForError(quicEOF(err))
+			return
+		}
+		go p.recvStream(stream)
+	}
+}
+
+// controlRoutine reads the control stream until the peer closes it.
+func (p *quicPeer) controlRoutine() {
+	_, err := io.Copy(ioutil.Discard, p.conn)
+	if err == nil {
+		err = io.EOF
+	}
+	p.stopForError(err)
+}
+
+// recvStream reads the messages of the stream and hands them over to the
+// deliverRoutine.
+func (p *quicPeer) recvStream(stream *quic.ReceiveStream) {
+	r := bufio.NewReader(stream)
+	chID, err := r.ReadByte()
+	if err != nil {
+		p.stopForError(quicEOF(err))
+		return
+	}
+	desc, ok := p.chDescs[chID]
+	if !ok {
+		p.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+	if p.reactorsByCh[chID] == nil {
+		p.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			p.stopForError(quicEOF(err))
+			return
+		}
+		if size > uint64(desc.RecvMessageCapacity) {
+			p.stopForError(fmt.Errorf(
+				"received message exceeds available capacity: %v < %v", desc.RecvMessageCapacity, size))
+			return
+		}
+		msgBytes := make([]byte, size)
+		if _, err := io.ReadFull(r, msgBytes); err != nil {
+			p.stopForError(quicEOF(err))
+			return
+		}
+		p.metrics.PeerReceiveBytesTotal.With("peer_id", string(p.ID()), "chID", chIDLabel(chID)).Add(float64(len(msgBytes)))
+		select {
+		case p.recvc <- quicMessage{chID, msgBytes}:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// deliverRoutine passes the received messages to the reactors of their
+// channels, one at a time.
+func (p *quicPeer) deliverRoutine() {
+	defer func() {
+		if r := recover(); r != nil {
+			p.stopForError(r)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-p.recvc:
+			p.reactorsByCh[msg.chID].Receive(msg.chID, p, msg.msgBytes)
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// stopForError reports the first error of the connection to the switch,
+// unless the peer is stopping anyway.
+func (p *quicPeer) stopForError(r interface{}) {
+	if p.ctx.Err() != nil {
+		return
+	}
+	p.stopOnce.Do(func() {
+		if p.onPeerError != nil {
+			p.onPeerError(p, r)
+		}
+	})
+}
+
+func (p *quicPeer) metricsReporter() {
+	for {
+		select {
+		case <-p.metricsTicker.C:
+			for chID, queue := range p.sendQueues {
+				labels := []string{"peer_id", string(p.ID()), "chID", chIDLabel(chID)}
+				p.metrics.PeerSendQueueSize.With(labels...).Set(float64(len(queue)))
+			}
+		case <-p.Quit():
+			return
+		}
+	}
+}
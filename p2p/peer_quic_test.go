@@ -0,0 +1,144 @@
+// +build quic
+
+package p2p
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	quic "github.com/quic-go/quic-go"
+
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmconn "github.com/tendermint/tendermint/p2p/conn"
+)
+
+func init() {
+	testProtocols = append(testProtocols, ProtocolQUIC)
+}
+
+func setTestPeerPersistent(p Peer) {
+	switch p := p.(type) {
+	case *peer:
+		p.persistent = true
+	case *quicPeer:
+		p.persistent = true
+	}
+}
+
+func createOutboundQUICPeerAndPerformHandshake(
+	addr *NetAddress,
+	config *config.P2PConfig,
+	pk crypto.PrivKey,
+	ourNodeInfo NodeInfo,
+	chDescs []*tmconn.ChannelDescriptor,
+	reactorsByCh map[byte]Reactor,
+) (Peer, error) {
+	c, err := testOutboundQUICConn(addr, config, pk)
+	if err != nil {
+		return nil, err
+	}
+	peerNodeInfo, err := handshake(c, 1*time.Second, ourNodeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	p := newQUICPeer(c, peerNodeInfo, peerConfig{
+		chDescs:      chDescs,
+		onPeerError:  func(p Peer, r interface{}) {},
+		outbound:     true,
+		reactorsByCh: reactorsByCh,
+	}, addr)
+	p.SetLogger(log.TestingLogger().With("peer", addr))
+	return p, nil
+}
+
+// newQUICTestNetwork returns the QUIC network remote peers listen on and dial
+// over, authenticating with the private key.
+func newQUICTestNetwork(privKey crypto.PrivKey) TransportNetwork {
+	tlsConfig, err := quicTLSConfig(NodeKey{PrivKey: privKey})
+	if err != nil {
+		panic(err)
+	}
+	return quicTestNetwork{tlsConfig}
+}
+
+// quicTestNetwork is a TransportNetwork handing out QUIC connections as
+// net.Conns over their control stream, which QUICTransports exchange
+// NodeInfo over.
+type quicTestNetwork struct {
+	tlsConfig *tls.Config
+}
+
+func (n quicTestNetwork) Listen(addr NetAddress) (net.Listener, error) {
+	ln, err := quic.ListenAddr(addr.DialString(), n.tlsConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	return quicTestListener{ln}, nil
+}
+
+func (n quicTestNetwork) Dial(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	c, err := quic.DialAddr(ctx, addr.DialString(), n.tlsConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	qc := newQUICConn(c)
+	qc.Stream = stream
+	return qc, nil
+}
+
+type quicTestListener struct {
+	*quic.Listener
+}
+
+// Accept returns the next connection whose control stream was opened,
+// skipping those closed before.
+func (l quicTestListener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		stream, err := c.AcceptStream(c.Context())
+		if err != nil {
+			continue
+		}
+		qc := newQUICConn(c)
+		qc.Stream = stream
+		return qc, nil
+	}
+}
+
+// testOutboundQUICConn dials addr over QUIC and checks the dialed ID matches
+// the connection ID.
+func testOutboundQUICConn(
+	addr *NetAddress,
+	config *config.P2PConfig,
+	ourNodePrivKey crypto.PrivKey,
+) (*quicConn, error) {
+	conn, err := testDial(addr, config, ourNodePrivKey)
+	if err != nil {
+		return nil, cmn.ErrorWrap(err, "Error creating peer")
+	}
+	c := conn.(*quicConn)
+
+	id, err := quicRemoteID(c.conn)
+	if err != nil || addr.ID != id {
+		_ = c.Close()
+		return nil, ErrSwitchAuthenticationFailure{addr, id}
+	}
+
+	return c, nil
+}
@@ -20,6 +20,10 @@ import (
 )
 
 func TestPeerBasic(t *testing.T) {
+	runWithProtocols(t, testPeerBasic)
+}
+
+func testPeerBasic(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
 	// simulate remote peer
@@ -37,13 +41,17 @@ func TestPeerBasic(t *testing.T) {
 	assert.True(p.IsRunning())
 	assert.True(p.IsOutbound())
 	assert.False(p.IsPersistent())
-	p.persistent = true
+	setTestPeerPersistent(p)
 	assert.True(p.IsPersistent())
 	assert.Equal(rp.Addr().DialString(), p.RemoteAddr().String())
 	assert.Equal(rp.ID(), p.ID())
 }
 
 func TestPeerSend(t *testing.T) {
+	runWithProtocols(t, testPeerSend)
+}
+
+func testPeerSend(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
 	config := cfg
@@ -61,7 +69,7 @@ func TestPeerSend(t *testing.T) {
 
 	defer p.Stop()
 
-	assert.True(p.CanSend(testCh))
+	assert.True(p.(interface{ CanSend(byte) bool }).CanSend(testCh))
 	assert.True(p.Send(testCh, []byte("Asylum")))
 }
 
@@ -69,18 +77,23 @@ func createOutboundPeerAndPerformHandshake(
 	addr *NetAddress,
 	config *config.P2PConfig,
 	mConfig tmconn.MConnConfig,
-) (*peer, error) {
+) (Peer, error) {
 	chDescs := []*tmconn.ChannelDescriptor{
 		{ID: testCh, Priority: 1},
 	}
 	reactorsByCh := map[byte]Reactor{testCh: NewTestReactor(chDescs, true)}
 	pk := ed25519.GenPrivKey()
+	timeout := 1 * time.Second
+	ourNodeInfo := testNodeInfo(addr.ID, "host_peer")
+
+	if addr.TransportProtocol() == ProtocolQUIC {
+		return createOutboundQUICPeerAndPerformHandshake(addr, config, pk, ourNodeInfo, chDescs, reactorsByCh)
+	}
+
 	pc, err := testOutboundPeerConn(addr, config, false, pk)
 	if err != nil {
 		return nil, err
 	}
-	timeout := 1 * time.Second
-	ourNodeInfo := testNodeInfo(addr.ID, "host_peer")
 	peerNodeInfo, err := handshake(pc.conn, timeout, ourNodeInfo)
 	if err != nil {
 		return nil, err
@@ -91,25 +104,38 @@ func createOutboundPeerAndPerformHandshake(
 	return p, nil
 }
 
-func testDial(addr *NetAddress, cfg *config.P2PConfig) (net.Conn, error) {
+func testDial(addr *NetAddress, cfg *config.P2PConfig, ourNodePrivKey crypto.PrivKey) (net.Conn, error) {
 	if cfg.TestDialFail {
 		return nil, fmt.Errorf("dial err (peerConfig.DialFail == true)")
 	}
 
-	conn, err := addr.DialTimeout(cfg.DialTimeout)
+	conn, err := testNetwork(addr.TransportProtocol(), ourNodePrivKey).Dial(*addr, cfg.DialTimeout)
 	if err != nil {
 		return nil, err
 	}
 	return conn, nil
 }
 
+// testNetwork returns the network remote peers listen on and dial over for
+// the protocol, authenticating with the private key over QUIC.
+func testNetwork(protocol string, privKey crypto.PrivKey) TransportNetwork {
+	switch protocol {
+	case ProtocolWebSocket:
+		return wsNetwork{}
+	case ProtocolQUIC:
+		return newQUICTestNetwork(privKey)
+	default:
+		return tcpNetwork{}
+	}
+}
+
 func testOutboundPeerConn(
 	addr *NetAddress,
 	config *config.P2PConfig,
 	persistent bool,
 	ourNodePrivKey crypto.PrivKey,
 ) (peerConn, error) {
-	conn, err := testDial(addr, config)
+	conn, err := testDial(addr, config, ourNodePrivKey)
 	if err != nil {
 		return peerConn{}, cmn.ErrorWrap(err, "Error creating peer")
 	}
@@ -155,12 +181,17 @@ func (rp *remotePeer) Start() {
 		rp.listenAddr = "127.0.0.1:0"
 	}
 
-	l, e := net.Listen("tcp", rp.listenAddr) // any available address
+	listenAddr, e := NewNetAddressStringWithOptionalID(withProtocol(testProtocol, rp.listenAddr))
+	if e != nil {
+		golog.Fatalf("invalid listen address %v: %+v", rp.listenAddr, e)
+	}
+	l, e := testNetwork(listenAddr.TransportProtocol(), rp.PrivKey).Listen(*listenAddr) // any available address
 	if e != nil {
-		golog.Fatalf("net.Listen tcp :0: %+v", e)
+		golog.Fatalf("Listen %v: %+v", listenAddr, e)
 	}
 	rp.listener = l
 	rp.addr = NewNetAddress(PubKeyToID(rp.PrivKey.PubKey()), l.Addr())
+	rp.addr.Protocol = listenAddr.Protocol
 	if rp.channels == nil {
 		rp.channels = []byte{testCh}
 	}
@@ -172,15 +203,15 @@ func (rp *remotePeer) Stop() {
 }
 
 func (rp *remotePeer) Dial(addr *NetAddress) (net.Conn, error) {
-	conn, err := addr.DialTimeout(1 * time.Second)
+	conn, err := testNetwork(addr.TransportProtocol(), rp.PrivKey).Dial(*addr, 1*time.Second)
 	if err != nil {
 		return nil, err
 	}
-	pc, err := testInboundPeerConn(conn, rp.Config, rp.PrivKey)
+	sc, err := rp.upgrade(conn, addr.TransportProtocol())
 	if err != nil {
 		return nil, err
 	}
-	_, err = handshake(pc.conn, time.Second, rp.nodeInfo())
+	_, err = handshake(sc, time.Second, rp.nodeInfo())
 	if err != nil {
 		return nil, err
 	}
@@ -200,12 +231,12 @@ func (rp *remotePeer) accept() {
 			return
 		}
 
-		pc, err := testInboundPeerConn(conn, rp.Config, rp.PrivKey)
+		sc, err := rp.upgrade(conn, rp.addr.TransportProtocol())
 		if err != nil {
 			golog.Fatalf("Failed to create a peer: %+v", err)
 		}
 
-		_, err = handshake(pc.conn, time.Second, rp.nodeInfo())
+		_, err = handshake(sc, time.Second, rp.nodeInfo())
 		if err != nil {
 			golog.Fatalf("Failed to perform handshake: %+v", err)
 		}
@@ -214,11 +245,24 @@ func (rp *remotePeer) accept() {
 	}
 }
 
+// upgrade returns the SecretConnection over conn to exchange NodeInfo over,
+// or conn itself for QUIC, which is secured already.
+func (rp *remotePeer) upgrade(conn net.Conn, protocol string) (net.Conn, error) {
+	if protocol == ProtocolQUIC {
+		return conn, nil
+	}
+	pc, err := testInboundPeerConn(conn, rp.Config, rp.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+	return pc.conn, nil
+}
+
 func (rp *remotePeer) nodeInfo() NodeInfo {
 	return DefaultNodeInfo{
 		ProtocolVersion: defaultProtocolVersion,
 		ID_:             rp.Addr().ID,
-		ListenAddr:      rp.listener.Addr().String(),
+		ListenAddr:      withProtocol(rp.addr.Protocol, rp.listener.Addr().String()),
 		Network:         "testing",
 		Version:         "1.2.3-rc0-deadbeef",
 		Channels:        rp.channels,
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -56,10 +57,16 @@ type AddrBook interface {
 	// Mark address
 	MarkGood(*p2p.NetAddress)
 	MarkAttempt(*p2p.NetAddress)
-	MarkBad(*p2p.NetAddress)
+	MarkBad(addr *p2p.NetAddress, reason string)
 
 	IsGood(*p2p.NetAddress) bool
 
+	// Ban or unban a peer ID, and list the bans in effect
+	Ban(id p2p.ID, banTime time.Duration, reason string)
+	Unban(id p2p.ID) bool
+	IsBanned(p2p.ID) bool
+	ListOfBans() []Ban
+
 	// Send a selection of addresses to peers
 	GetSelection() []*p2p.NetAddress
 	// Send a selection of addresses with bias
@@ -90,6 +97,7 @@ type addrBook struct {
 	ourAddrs   map[string]struct{}
 	privateIDs map[p2p.ID]struct{}
 	addrLookup map[p2p.ID]*knownAddress // new & old
+	bans       map[p2p.ID]*Ban
 	bucketsOld []map[string]*knownAddress
 	bucketsNew []map[string]*knownAddress
 	nOld       int
@@ -106,6 +114,7 @@ func NewAddrBook(filePath string, routabilityStrict bool) *addrBook {
 		ourAddrs:          make(map[string]struct{}),
 		privateIDs:        make(map[p2p.ID]struct{}),
 		addrLookup:        make(map[p2p.ID]*knownAddress),
+		bans:              make(map[p2p.ID]*Ban),
 		filePath:          filePath,
 		routabilityStrict: routabilityStrict,
 	}
@@ -287,6 +296,12 @@ func (a *addrBook) PickAddress(biasTowardsNewAddrs int) *p2p.NetAddress {
 	randIndex := a.rand.Intn(len(bucket))
 	for _, ka := range bucket {
 		if randIndex == 0 {
+			// NOTE: banned addresses are removed from the book and refused
+			// by addAddress, so this is only a safeguard.
+			if a.isBanned(ka.ID()) {
+				a.removeFromAllBuckets(ka)
+				return nil
+			}
 			return ka.Addr
 		}
 		randIndex--
@@ -322,10 +337,66 @@ func (a *addrBook) MarkAttempt(addr *p2p.NetAddress) {
 	ka.markAttempt()
 }
 
-// MarkBad implements AddrBook. Currently it just ejects the address.
-// TODO: black list for some amount of time
-func (a *addrBook) MarkBad(addr *p2p.NetAddress) {
-	a.RemoveAddress(addr)
+// MarkBad implements AddrBook. It ejects the address and bans its ID for
+// defaultBanTime, so that it isn't re-learned from other peers.
+func (a *addrBook) MarkBad(addr *p2p.NetAddress, reason string) {
+	a.Ban(addr.ID, defaultBanTime, reason)
+}
+
+// Ban implements AddrBook - it removes the addresses with the given ID from
+// the book and refuses them until banTime has elapsed. A non-positive
+// banTime bans the ID permanently. Banning an ID again replaces its ban.
+func (a *addrBook) Ban(id p2p.ID, banTime time.Duration, reason string) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ban := &Ban{ID: id, Reason: reason}
+	if banTime > 0 {
+		ban.Expires = time.Now().Add(banTime)
+	}
+	a.Logger.Info("Ban address", "id", id, "expires", ban.Expires, "reason", reason)
+	a.bans[id] = ban
+
+	if ka := a.addrLookup[id]; ka != nil {
+		a.removeFromAllBuckets(ka)
+	}
+}
+
+// Unban implements AddrBook - it lifts the ban of the given ID. Returns false
+// if the ID wasn't banned.
+func (a *addrBook) Unban(id p2p.ID) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if !a.isBanned(id) {
+		return false
+	}
+	a.Logger.Info("Unban address", "id", id)
+	delete(a.bans, id)
+	return true
+}
+
+// IsBanned implements AddrBook - it returns true if the ID is banned.
+func (a *addrBook) IsBanned(id p2p.ID) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	return a.isBanned(id)
+}
+
+// ListOfBans implements AddrBook - it returns the bans in effect, ordered by
+// ID.
+func (a *addrBook) ListOfBans() []Ban {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.expireBans()
+	bans := make([]Ban, 0, len(a.bans))
+	for _, ban := range a.bans {
+		bans = append(bans, *ban)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].ID < bans[j].ID })
+	return bans
 }
 
 // GetSelection implements AddrBook.
@@ -679,6 +750,10 @@ func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
 		return ErrAddrBookPrivateSrc{src}
 	}
 
+	if a.isBanned(addr.ID) {
+		return ErrAddrBookBanned{addr}
+	}
+
 	ka := a.addrLookup[addr.ID]
 	if ka != nil {
 		// If its already old and the addr is the same, ignore it.
@@ -703,6 +778,30 @@ func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
 	return nil
 }
 
+// isBanned returns true if id has a ban which hasn't expired. Expired bans
+// are removed.
+func (a *addrBook) isBanned(id p2p.ID) bool {
+	ban, ok := a.bans[id]
+	if !ok {
+		return false
+	}
+	if ban.expired(time.Now()) {
+		delete(a.bans, id)
+		return false
+	}
+	return true
+}
+
+// expireBans removes the expired bans.
+func (a *addrBook) expireBans() {
+	now := time.Now()
+	for id, ban := range a.bans {
+		if ban.expired(now) {
+			delete(a.bans, id)
+		}
+	}
+}
+
 // Make space in the new buckets by expiring the really bad entries.
 // If no bad entries are available we remove the oldest.
 func (a *addrBook) expireNew(bucketIdx int) {
@@ -812,20 +911,25 @@ func (a *addrBook) groupKey(na *p2p.NetAddress) string {
 	if a.routabilityStrict && !na.Routable() {
 		return "unroutable"
 	}
+	return networkGroupKey(na)
+}
 
+// networkGroupKey returns the network of the address: the /16 for IPv4, the
+// /32 (/36 for he.net) for IPv6.
+func networkGroupKey(na *p2p.NetAddress) string {
+	// NOTE: net.IPNet#String doesn't apply the mask, so the IPs are masked
+	// explicitly to group them by network.
 	if ipv4 := na.IP.To4(); ipv4 != nil {
-		return (&net.IPNet{IP: na.IP, Mask: net.CIDRMask(16, 32)}).String()
+		return ipv4GroupKey(ipv4)
 	}
 	if na.RFC6145() || na.RFC6052() {
 		// last four bytes are the ip address
-		ip := net.IP(na.IP[12:16])
-		return (&net.IPNet{IP: ip, Mask: net.CIDRMask(16, 32)}).String()
+		return ipv4GroupKey(net.IP(na.IP[12:16]))
 	}
 
 	if na.RFC3964() {
-		ip := net.IP(na.IP[2:7])
-		return (&net.IPNet{IP: ip, Mask: net.CIDRMask(16, 32)}).String()
-
+		// 6to4 addresses embed the ip address after the 2002::/16 prefix
+		return ipv4GroupKey(net.IP(na.IP[2:6]))
 	}
 	if na.RFC4380() {
 		// teredo tunnels have the last 4 bytes as the v4 address XOR
@@ -834,7 +938,7 @@ func (a *addrBook) groupKey(na *p2p.NetAddress) string {
 		for i, byte := range na.IP[12:16] {
 			ip[i] = byte ^ 0xff
 		}
-		return (&net.IPNet{IP: ip, Mask: net.CIDRMask(16, 32)}).String()
+		return ipv4GroupKey(ip)
 	}
 
 	// OK, so now we know ourselves to be a IPv6 address.
@@ -847,7 +951,14 @@ func (a *addrBook) groupKey(na *p2p.NetAddress) string {
 		bits = 36
 	}
 
-	return (&net.IPNet{IP: na.IP, Mask: net.CIDRMask(bits, 128)}).String()
+	mask := net.CIDRMask(bits, 128)
+	return (&net.IPNet{IP: na.IP.Mask(mask), Mask: mask}).String()
+}
+
+// ipv4GroupKey returns the /16 network of the given IPv4 address.
+func ipv4GroupKey(ip net.IP) string {
+	mask := net.CIDRMask(16, 32)
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
 }
 
 // doubleSha256 calculates sha256(sha256(b)) and returns the resulting bytes.
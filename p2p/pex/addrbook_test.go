@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -417,3 +419,112 @@ func TestPrivatePeers(t *testing.T) {
 		assert.True(t, ok)
 	}
 }
+
+func TestAddrBookGroupKey(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, false)
+	book.SetLogger(log.TestingLogger())
+
+	testCases := []struct {
+		ip       string
+		groupKey string
+	}{
+		// IPv4 addresses are grouped by /16
+		{"1.2.3.4", "1.2.0.0/16"},
+		{"1.2.200.100", "1.2.0.0/16"},
+		{"::ffff:1.2.3.4", "1.2.0.0/16"},
+		// IPv6 addresses embedding an IPv4 address are grouped by the latter
+		{"::ffff:0:1.2.3.4", "1.2.0.0/16"},                     // RFC6145
+		{"64:ff9b::1.2.3.4", "1.2.0.0/16"},                     // RFC6052
+		{"2002:102:304::1", "1.2.0.0/16"},                      // RFC3964 (6to4)
+		{"2001:0:4136:e378:8000:63bf:fefd:fcfb", "1.2.0.0/16"}, // RFC4380 (teredo)
+		// other IPv6 addresses are grouped by /32, or /36 for he.net
+		{"2607:f8b0:4005:805::200e", "2607:f8b0::/32"},
+		{"2607:f8b0:1:2::3", "2607:f8b0::/32"},
+		{"2001:470:1f2d:1234::1", "2001:470:1000::/36"},
+	}
+
+	for _, tc := range testCases {
+		addr := p2p.NewNetAddressIPPort(net.ParseIP(tc.ip), 26656)
+		assert.Equal(t, tc.groupKey, book.groupKey(addr), tc.ip)
+	}
+}
+
+func TestAddrBookBan(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	addr := randIPv4Address(t)
+	src := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, src))
+
+	// banning removes the address, which can't be added back
+	book.MarkBad(addr, "misbehaving")
+	assert.False(t, book.HasAddress(addr))
+	assert.True(t, book.IsBanned(addr.ID))
+	err := book.AddAddress(addr, src)
+	if assert.Error(t, err) {
+		_, ok := err.(ErrAddrBookBanned)
+		assert.True(t, ok)
+	}
+	assert.Nil(t, book.PickAddress(50))
+
+	bans := book.ListOfBans()
+	require.Len(t, bans, 1)
+	assert.Equal(t, addr.ID, bans[0].ID)
+	assert.Equal(t, "misbehaving", bans[0].Reason)
+	assert.False(t, bans[0].Expires.IsZero())
+
+	// unbanning allows the address again
+	assert.True(t, book.Unban(addr.ID))
+	assert.False(t, book.Unban(addr.ID))
+	assert.False(t, book.IsBanned(addr.ID))
+	assert.NoError(t, book.AddAddress(addr, src))
+	assert.Empty(t, book.ListOfBans())
+}
+
+func TestAddrBookBanExpiry(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	addr := randIPv4Address(t)
+	book.Ban(addr.ID, 10*time.Millisecond, "temporary")
+	assert.True(t, book.IsBanned(addr.ID))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, book.IsBanned(addr.ID))
+	assert.Empty(t, book.ListOfBans())
+	assert.NoError(t, book.AddAddress(addr, randIPv4Address(t)))
+}
+
+func TestAddrBookBanSaveLoad(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	permanent := randIPv4Address(t)
+	expired := randIPv4Address(t)
+	book.Ban(permanent.ID, 0, "permanent")
+	book.Ban(expired.ID, time.Nanosecond, "expired")
+	book.saveToFile(fname)
+
+	book = NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	book.loadFromFile(fname)
+
+	assert.True(t, book.IsBanned(permanent.ID))
+	assert.False(t, book.IsBanned(expired.ID))
+	bans := book.ListOfBans()
+	require.Len(t, bans, 1)
+	assert.Equal(t, Ban{ID: permanent.ID, Reason: "permanent"}, bans[0])
+}
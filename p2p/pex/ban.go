@@ -0,0 +1,23 @@
+package pex
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// Ban is an entry of the address book's ban list. Addresses with a banned ID
+// are neither added to the book nor dialed, and inbound connections from the
+// ID are refused by the switch.
+type Ban struct {
+	ID     p2p.ID `json:"id"`
+	Reason string `json:"reason"`
+	// Expires is the time at which the ban is lifted, or zero if it is
+	// permanent.
+	Expires time.Time `json:"expires"`
+}
+
+// expired returns true if the ban is no longer in effect at the given time.
+func (ban *Ban) expired(now time.Time) bool {
+	return !ban.Expires.IsZero() && !now.Before(ban.Expires)
+}
@@ -0,0 +1,333 @@
+package pex
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+const (
+	// number of dial attempts remembered for every crawled address
+	crawlHistorySize = 16
+
+	// upper bound on the number of addresses tracked by the crawler, beyond
+	// which the least valuable records are evicted
+	maxCrawlRecords = 10000
+
+	// number of the least valuable records evicted at once when the crawler
+	// is full, so that the records are only sorted once in a while
+	crawlEvictBatchSize = maxCrawlRecords / 100
+
+	// addresses successfully dialed within this period are considered
+	// reachable and are served to peers requesting addresses from a seed
+	defaultCrawlReachablePeriod = 1 * time.Hour
+)
+
+// CrawlAttempt is the outcome of a single dial performed by the crawler.
+type CrawlAttempt struct {
+	Time    time.Time `json:"time"`
+	Success bool      `json:"success"`
+	// dial + handshake; only set on successful dials, 0 for peers found
+	// already connected
+	Latency time.Duration `json:"latency"`
+}
+
+// CrawlNodeInfo is what the crawler remembers about the node behind an
+// address from its last successful handshake.
+type CrawlNodeInfo struct {
+	ID       p2p.ID       `json:"id"`
+	Network  string       `json:"network"`
+	Version  string       `json:"version"`
+	Moniker  string       `json:"moniker"`
+	Channels cmn.HexBytes `json:"channels"`
+}
+
+// CrawlRecord holds the reachability history of an address discovered by the
+// crawler.
+type CrawlRecord struct {
+	Addr        *p2p.NetAddress `json:"addr"`
+	NodeInfo    *CrawlNodeInfo  `json:"node_info,omitempty"`
+	Attempts    []CrawlAttempt  `json:"attempts"`  // oldest first
+	Successes   int             `json:"successes"` // successful Attempts
+	LastAttempt time.Time       `json:"last_attempt"`
+	LastSuccess time.Time       `json:"last_success"`
+}
+
+// Reachability returns the fraction of the remembered dial attempts which
+// succeeded, or 0 if the address has not been dialed yet.
+func (cr *CrawlRecord) Reachability() float64 {
+	if len(cr.Attempts) == 0 {
+		return 0
+	}
+	return float64(cr.Successes) / float64(len(cr.Attempts))
+}
+
+// Latency returns the average latency of the remembered successful dial
+// attempts, or 0 if there were none.
+func (cr *CrawlRecord) Latency() time.Duration {
+	var (
+		total     time.Duration
+		successes int64
+	)
+	for _, a := range cr.Attempts {
+		if a.Success && a.Latency > 0 {
+			total += a.Latency
+			successes++
+		}
+	}
+	if successes == 0 {
+		return 0
+	}
+	return total / time.Duration(successes)
+}
+
+// reachableAt returns true if the address was successfully dialed within
+// period before now.
+func (cr *CrawlRecord) reachableAt(now time.Time, period time.Duration) bool {
+	return !cr.LastSuccess.IsZero() && now.Sub(cr.LastSuccess) < period
+}
+
+func (cr *CrawlRecord) copy() CrawlRecord {
+	c := *cr
+	c.Attempts = make([]CrawlAttempt, len(cr.Attempts))
+	copy(c.Attempts, cr.Attempts)
+	if cr.NodeInfo != nil {
+		ni := *cr.NodeInfo
+		c.NodeInfo = &ni
+	}
+	return c
+}
+
+//-----------------------------------------------------------------------------
+
+// crawler keeps track of every address learned in seed/crawler mode,
+// independently of the address book and its capacity, together with the
+// results of dialing it.
+type crawler struct {
+	mtx             sync.Mutex
+	records         map[p2p.ID]*CrawlRecord
+	reachablePeriod time.Duration
+}
+
+func newCrawler() *crawler {
+	return &crawler{
+		records:         make(map[p2p.ID]*CrawlRecord),
+		reachablePeriod: defaultCrawlReachablePeriod,
+	}
+}
+
+// addAddress starts tracking addr, evicting another record if the crawler is
+// full. It returns false if the address has no ID. Known addresses are left
+// untouched.
+func (c *crawler) addAddress(addr *p2p.NetAddress) bool {
+	if addr == nil || len(addr.ID) == 0 {
+		return false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.records[addr.ID]; ok {
+		return true
+	}
+	c.addRecordLocked(&CrawlRecord{Addr: addr}, time.Now())
+	return true
+}
+
+// removeAddress stops tracking the address with the given ID.
+func (c *crawler) removeAddress(id p2p.ID) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.records, id)
+}
+
+func (c *crawler) addRecordLocked(rec *CrawlRecord, now time.Time) {
+	if len(c.records) >= maxCrawlRecords {
+		c.evictLocked(now)
+	}
+	c.records[rec.Addr.ID] = rec
+}
+
+// evictLocked removes the crawlEvictBatchSize records least worth keeping:
+// the least recently attempted of the records which aren't reachable (never
+// dialed ones first), then the least recently reached ones. Reachable
+// addresses can thus not be pushed out by a flood of addresses which don't
+// work.
+func (c *crawler) evictLocked(now time.Time) {
+	records := make([]*CrawlRecord, 0, len(c.records))
+	for _, rec := range c.records {
+		records = append(records, rec)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return c.worseLocked(records[i], records[j], now)
+	})
+	if len(records) > crawlEvictBatchSize {
+		records = records[:crawlEvictBatchSize]
+	}
+	for _, rec := range records {
+		delete(c.records, rec.Addr.ID)
+	}
+}
+
+func (c *crawler) worseLocked(a, b *CrawlRecord, now time.Time) bool {
+	aReachable := a.reachableAt(now, c.reachablePeriod)
+	bReachable := b.reachableAt(now, c.reachablePeriod)
+	switch {
+	case aReachable != bReachable:
+		return !aReachable
+	case !aReachable:
+		return a.LastAttempt.Before(b.LastAttempt)
+	default:
+		return a.LastSuccess.Before(b.LastSuccess)
+	}
+}
+
+// recordAttempt stores the outcome of dialing addr. nodeInfo may be nil.
+func (c *crawler) recordAttempt(addr *p2p.NetAddress, now time.Time,
+	latency time.Duration, err error, nodeInfo p2p.NodeInfo) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	rec, ok := c.records[addr.ID]
+	if !ok {
+		rec = &CrawlRecord{Addr: addr}
+		c.addRecordLocked(rec, now)
+	}
+
+	attempt := CrawlAttempt{Time: now, Success: err == nil}
+	rec.LastAttempt = now
+	if err == nil {
+		attempt.Latency = latency
+		rec.LastSuccess = now
+		rec.Successes++
+		if ni, ok := nodeInfo.(p2p.DefaultNodeInfo); ok {
+			rec.NodeInfo = &CrawlNodeInfo{
+				ID:       ni.ID(),
+				Network:  ni.Network,
+				Version:  ni.Version,
+				Moniker:  ni.Moniker,
+				Channels: ni.Channels,
+			}
+		}
+	}
+	rec.Attempts = append(rec.Attempts, attempt)
+	if len(rec.Attempts) > crawlHistorySize {
+		forgotten := rec.Attempts[:len(rec.Attempts)-crawlHistorySize]
+		for _, a := range forgotten {
+			if a.Success {
+				rec.Successes--
+			}
+		}
+		rec.Attempts = rec.Attempts[len(rec.Attempts)-crawlHistorySize:]
+	}
+}
+
+// peersToCrawl returns every tracked address, least recently attempted first.
+func (c *crawler) peersToCrawl() []crawlPeerInfo {
+	c.mtx.Lock()
+	of := make(oldestFirst, 0, len(c.records))
+	for _, rec := range c.records {
+		of = append(of, crawlPeerInfo{
+			Addr:        rec.Addr,
+			LastAttempt: rec.LastAttempt,
+			LastSuccess: rec.LastSuccess,
+		})
+	}
+	c.mtx.Unlock()
+
+	sort.Sort(of)
+	return of
+}
+
+// reachableAddrs returns up to max randomly chosen addresses that were
+// successfully dialed within the reachable period.
+func (c *crawler) reachableAddrs(now time.Time, max int) []*p2p.NetAddress {
+	c.mtx.Lock()
+	addrs := make([]*p2p.NetAddress, 0, len(c.records))
+	for _, rec := range c.records {
+		if rec.reachableAt(now, c.reachablePeriod) {
+			addrs = append(addrs, rec.Addr)
+		}
+	}
+	c.mtx.Unlock()
+
+	if max > len(addrs) {
+		max = len(addrs)
+	}
+	selection := make([]*p2p.NetAddress, 0, max)
+	for _, i := range cmn.RandPerm(len(addrs))[:max] {
+		selection = append(selection, addrs[i])
+	}
+	return selection
+}
+
+// report returns a copy of all records, most reachable first.
+func (c *crawler) report() []CrawlRecord {
+	c.mtx.Lock()
+	records := make([]CrawlRecord, 0, len(c.records))
+	for _, rec := range c.records {
+		records = append(records, rec.copy())
+	}
+	c.mtx.Unlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		ri, rj := records[i].Reachability(), records[j].Reachability()
+		if ri != rj {
+			return ri > rj
+		}
+		if !records[i].LastSuccess.Equal(records[j].LastSuccess) {
+			return records[i].LastSuccess.After(records[j].LastSuccess)
+		}
+		return records[i].Addr.ID < records[j].Addr.ID
+	})
+	return records
+}
+
+// saveToFile writes all records to filePath.
+func (c *crawler) saveToFile(filePath string) error {
+	c.mtx.Lock()
+	records := make([]*CrawlRecord, 0, len(c.records))
+	for _, rec := range c.records {
+		rec := rec.copy()
+		records = append(records, &rec)
+	}
+	c.mtx.Unlock()
+
+	jsonBytes, err := json.MarshalIndent(records, "", "\t")
+	if err != nil {
+		return err
+	}
+	return cmn.WriteFileAtomic(filePath, jsonBytes, 0644)
+}
+
+// loadFromFile restores the records saved in filePath, if it exists.
+func (c *crawler) loadFromFile(filePath string) error {
+	jsonBytes, err := ioutil.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var records []*CrawlRecord
+	if err := json.Unmarshal(jsonBytes, &records); err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	now := time.Now()
+	for _, rec := range records {
+		if rec.Addr == nil || len(rec.Addr.ID) == 0 {
+			continue
+		}
+		c.addRecordLocked(rec, now)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package pex
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func TestCrawlerRecordsReachabilityHistory(t *testing.T) {
+	c := newCrawler()
+	_, addr := p2p.CreateRoutableAddr()
+	require.True(t, c.addAddress(addr))
+	assert.False(t, c.addAddress(&p2p.NetAddress{IP: addr.IP, Port: addr.Port}), "addresses without ID are not tracked")
+
+	nodeInfo := p2p.DefaultNodeInfo{
+		ID_:      addr.ID,
+		Network:  "testing",
+		Version:  "1.2.3",
+		Moniker:  "crawled",
+		Channels: []byte{PexChannel},
+	}
+	now := time.Now()
+	c.recordAttempt(addr, now, 0, errors.New("dial failed"), nil)
+	c.recordAttempt(addr, now.Add(time.Second), 100*time.Millisecond, nil, nodeInfo)
+	c.recordAttempt(addr, now.Add(2*time.Second), 300*time.Millisecond, nil, nodeInfo)
+
+	report := c.report()
+	require.Len(t, report, 1)
+	rec := report[0]
+	assert.Len(t, rec.Attempts, 3)
+	assert.Equal(t, 2, rec.Successes)
+	assert.InDelta(t, 2.0/3.0, rec.Reachability(), 0.001)
+	assert.Equal(t, 200*time.Millisecond, rec.Latency())
+	assert.Equal(t, now.Add(2*time.Second), rec.LastAttempt)
+	assert.Equal(t, now.Add(2*time.Second), rec.LastSuccess)
+	require.NotNil(t, rec.NodeInfo)
+	assert.Equal(t, "crawled", rec.NodeInfo.Moniker)
+	assert.Equal(t, "testing", rec.NodeInfo.Network)
+	assert.Equal(t, "1.2.3", rec.NodeInfo.Version)
+
+	// only the most recent attempts are kept
+	for i := 0; i < 2*crawlHistorySize; i++ {
+		c.recordAttempt(addr, now.Add(time.Minute), 0, errors.New("dial failed"), nil)
+	}
+	rec = c.report()[0]
+	assert.Len(t, rec.Attempts, crawlHistorySize)
+	assert.Zero(t, rec.Successes)
+	assert.Equal(t, 0.0, rec.Reachability())
+	assert.Equal(t, now.Add(2*time.Second), rec.LastSuccess)
+}
+
+func TestCrawlerServesOnlyRecentlyReachableAddrs(t *testing.T) {
+	c := newCrawler()
+	now := time.Now()
+
+	_, reachable := p2p.CreateRoutableAddr()
+	_, stale := p2p.CreateRoutableAddr()
+	_, unreachable := p2p.CreateRoutableAddr()
+	_, untested := p2p.CreateRoutableAddr()
+	c.addAddress(untested)
+	c.recordAttempt(reachable, now, time.Millisecond, nil, nil)
+	c.recordAttempt(stale, now.Add(-2*c.reachablePeriod), time.Millisecond, nil, nil)
+	c.recordAttempt(unreachable, now, 0, errors.New("dial failed"), nil)
+
+	addrs := c.reachableAddrs(now, maxGetSelection)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, reachable, addrs[0])
+	assert.Empty(t, c.reachableAddrs(now, 0))
+
+	report := c.report()
+	require.Len(t, report, 4)
+	assert.Equal(t, reachable.ID, report[0].Addr.ID, "most reachable, most recent first")
+	assert.Equal(t, stale.ID, report[1].Addr.ID)
+
+	// least recently attempted first
+	toCrawl := c.peersToCrawl()
+	require.Len(t, toCrawl, 4)
+	assert.Equal(t, untested.ID, toCrawl[0].Addr.ID)
+	assert.Equal(t, stale.ID, toCrawl[1].Addr.ID)
+}
+
+func TestCrawlerEvictsUnreachableRecordsFirst(t *testing.T) {
+	c := newCrawler()
+	now := time.Now()
+
+	_, reachable := p2p.CreateRoutableAddr()
+	c.recordAttempt(reachable, now, time.Millisecond, nil, nil)
+
+	// a flood of addresses which don't work can't push out a reachable one
+	for i := 0; i < maxCrawlRecords+10; i++ {
+		addr := p2p.NewNetAddressIPPort(net.IPv4(1, 2, byte(i>>8), byte(i)), 26656)
+		addr.ID = p2p.ID(fmt.Sprintf("%040x", i))
+		require.True(t, c.addAddress(addr))
+	}
+	// the least valuable records are evicted in a batch
+	report := c.report()
+	assert.Len(t, report, 1+maxCrawlRecords+10-crawlEvictBatchSize)
+	assert.Equal(t, reachable.ID, report[0].Addr.ID)
+}
+
+func TestCrawlerSaveLoad(t *testing.T) {
+	fname := createTempFileName("crawl_test")
+	defer deleteTempFile(fname)
+
+	c := newCrawler()
+	now := time.Now()
+	_, reachable := p2p.CreateRoutableAddr()
+	_, untested := p2p.CreateRoutableAddr()
+	c.addAddress(untested)
+	c.recordAttempt(reachable, now, time.Millisecond, nil, p2p.DefaultNodeInfo{ID_: reachable.ID, Moniker: "crawled"})
+	require.NoError(t, c.saveToFile(fname))
+
+	loaded := newCrawler()
+	require.NoError(t, loaded.loadFromFile(fname))
+	report := loaded.report()
+	require.Len(t, report, 2)
+	assert.Equal(t, reachable.ID, report[0].Addr.ID)
+	assert.Equal(t, "crawled", report[0].NodeInfo.Moniker)
+	assert.True(t, report[0].LastSuccess.Equal(now))
+	assert.Equal(t, untested.ID, report[1].Addr.ID)
+
+	// a missing file is not an error
+	assert.NoError(t, newCrawler().loadFromFile(fname+".missing"))
+}
@@ -62,3 +62,11 @@ type ErrAddrBookInvalidAddrNoID struct {
 func (err ErrAddrBookInvalidAddrNoID) Error() string {
 	return fmt.Sprintf("Cannot add address with no ID %v", err.Addr)
 }
+
+type ErrAddrBookBanned struct {
+	Addr *p2p.NetAddress
+}
+
+func (err ErrAddrBookBanned) Error() string {
+	return fmt.Sprintf("Cannot add banned address %v", err.Addr)
+}
@@ -0,0 +1,73 @@
+package pex
+
+import (
+	"net"
+	"sync"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// minObservedAddrReports is the number of outbound peers in distinct network
+// groups (see networkGroupKey) which have to report seeing us on the same IP
+// before it's adopted as our external address. They also have to be a
+// majority of the network groups of all reporting peers, so that an attacker
+// needs to control connections from many networks to pick our address.
+const minObservedAddrReports = 3
+
+// observedAddrReport is the IP a peer sees us on, and the network group of
+// the peer.
+type observedAddrReport struct {
+	ip    string
+	group string
+}
+
+// observedAddrs tallies the IPs our outbound peers see us on.
+type observedAddrs struct {
+	mtx     sync.Mutex
+	byPeer  map[p2p.ID]observedAddrReport
+	adopted string // IP adopted as our external address
+}
+
+func newObservedAddrs() *observedAddrs {
+	return &observedAddrs{
+		byPeer: make(map[p2p.ID]observedAddrReport),
+	}
+}
+
+// add records that the peer with the given ID, whose IP is in the given
+// network group, sees us on ip. It returns true if ip is now reported from
+// at least minObservedAddrReports network groups, which are a majority of the
+// groups of all reporting peers, and differs from the one adopted so far, in
+// which case it becomes the adopted one.
+func (oa *observedAddrs) add(id p2p.ID, group string, ip net.IP) bool {
+	oa.mtx.Lock()
+	defer oa.mtx.Unlock()
+
+	key := ip.String()
+	oa.byPeer[id] = observedAddrReport{ip: key, group: group}
+	if key == oa.adopted {
+		return false
+	}
+
+	// peers from the same network group only count once
+	agreeing := make(map[string]struct{})
+	all := make(map[string]struct{})
+	for _, report := range oa.byPeer {
+		all[report.group] = struct{}{}
+		if report.ip == key {
+			agreeing[report.group] = struct{}{}
+		}
+	}
+	if len(agreeing) < minObservedAddrReports || 2*len(agreeing) <= len(all) {
+		return false
+	}
+	oa.adopted = key
+	return true
+}
+
+// remove forgets the report of the peer with the given ID.
+func (oa *observedAddrs) remove(id p2p.ID) {
+	oa.mtx.Lock()
+	defer oa.mtx.Unlock()
+	delete(oa.byPeer, id)
+}
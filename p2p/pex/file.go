@@ -13,6 +13,7 @@ import (
 type addrBookJSON struct {
 	Key   string          `json:"key"`
 	Addrs []*knownAddress `json:"addrs"`
+	Bans  []*Ban          `json:"bans,omitempty"`
 }
 
 func (a *addrBook) saveToFile(filePath string) {
@@ -25,10 +26,17 @@ func (a *addrBook) saveToFile(filePath string) {
 	for _, ka := range a.addrLookup {
 		addrs = append(addrs, ka)
 	}
+	// Compile Bans
+	a.expireBans()
+	bans := []*Ban{}
+	for _, ban := range a.bans {
+		bans = append(bans, ban)
+	}
 
 	aJSON := &addrBookJSON{
 		Key:   a.key,
 		Addrs: addrs,
+		Bans:  bans,
 	}
 
 	jsonBytes, err := json.MarshalIndent(aJSON, "", "\t")
@@ -67,8 +75,16 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 	// Restore all the fields...
 	// Restore the key
 	a.key = aJSON.Key
+	// Restore .bans
+	for _, ban := range aJSON.Bans {
+		a.bans[ban.ID] = ban
+	}
+	a.expireBans()
 	// Restore .bucketsNew & .bucketsOld
 	for _, ka := range aJSON.Addrs {
+		if a.isBanned(ka.ID()) {
+			continue
+		}
 		for _, bucketIndex := range ka.Buckets {
 			bucket := a.getBucket(ka.BucketType, bucketIndex)
 			bucket[ka.Addr.String()] = ka
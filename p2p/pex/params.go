@@ -52,4 +52,7 @@ const (
 	// max addresses returned by GetSelection
 	// NOTE: this must match "maxMsgSize"
 	maxGetSelection = 250
+
+	// duration for which an address marked as bad is banned.
+	defaultBanTime = 24 * time.Hour
 )
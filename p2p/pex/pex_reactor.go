@@ -2,8 +2,8 @@ package pex
 
 import (
 	"fmt"
+	"net"
 	"reflect"
-	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +21,11 @@ const (
 	// PexChannel is a channel for PEX messages
 	PexChannel = byte(0x00)
 
+	// PexObservedAddrChannel is a channel for asking peers which address they
+	// see us on. It's separate from PexChannel so peers which don't support it
+	// are never sent these messages.
+	PexObservedAddrChannel = byte(0x08)
+
 	// over-estimate of max NetAddress size
 	// hexID (40) + IP (16) + Port (2) + Name (100) ...
 	// NOTE: dont use massive DNS name ..
@@ -74,8 +79,17 @@ type PEXReactor struct {
 	requestsSent         *cmn.CMap // ID->struct{}: unanswered send requests
 	lastReceivedRequests *cmn.CMap // ID->time.Time: last time peer requested from us
 
+	disabledPeers *cmn.CMap // ID->struct{}: peers we don't exchange addresses with
+
 	seedAddrs []*p2p.NetAddress
 
+	crawler *crawler // seed/crawler mode only
+
+	// external address discovery
+	observedAddrs                *observedAddrs
+	observedAddrRequestsSent     *cmn.CMap // ID->struct{}: unanswered observed address requests
+	observedAddrRequestsReceived *cmn.CMap // ID->struct{}: peers we told their address
+
 	attemptsToDial sync.Map // address (string) -> {number of attempts (int), last time dialed (time.Time)}
 }
 
@@ -93,6 +107,15 @@ type PEXReactorConfig struct {
 	// Seeds is a list of addresses reactor may use
 	// if it can't connect to peers in the addrbook.
 	Seeds []string
+
+	// DiscoverExternalAddr makes the reactor ask outbound peers which IP they
+	// see us on, and advertise it once enough of them, from a majority of
+	// distinct networks, agree.
+	DiscoverExternalAddr bool
+
+	// CrawlFile is where the crawler's records are persisted in seed mode.
+	// They are kept in memory only if empty.
+	CrawlFile string
 }
 
 type _attemptsToDial struct {
@@ -108,6 +131,14 @@ func NewPEXReactor(b AddrBook, config *PEXReactorConfig) *PEXReactor {
 		ensurePeersPeriod:    defaultEnsurePeersPeriod,
 		requestsSent:         cmn.NewCMap(),
 		lastReceivedRequests: cmn.NewCMap(),
+		disabledPeers:        cmn.NewCMap(),
+
+		observedAddrs:                newObservedAddrs(),
+		observedAddrRequestsSent:     cmn.NewCMap(),
+		observedAddrRequestsReceived: cmn.NewCMap(),
+	}
+	if config.SeedMode {
+		r.crawler = newCrawler()
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEXReactor", r)
 	return r
@@ -132,6 +163,11 @@ func (r *PEXReactor) OnStart() error {
 	// Check if this node should run
 	// in seed/crawler mode
 	if r.config.SeedMode {
+		if r.config.CrawlFile != "" {
+			if err := r.crawler.loadFromFile(r.config.CrawlFile); err != nil {
+				r.Logger.Error("Failed to load crawl records", "file", r.config.CrawlFile, "err", err)
+			}
+		}
 		go r.crawlPeersRoutine()
 	} else {
 		go r.ensurePeersRoutine()
@@ -142,6 +178,9 @@ func (r *PEXReactor) OnStart() error {
 // OnStop implements BaseService
 func (r *PEXReactor) OnStop() {
 	r.book.Stop()
+	if r.crawler != nil {
+		r.saveCrawlRecords()
+	}
 }
 
 // GetChannels implements Reactor
@@ -152,6 +191,11 @@ func (r *PEXReactor) GetChannels() []*conn.ChannelDescriptor {
 			Priority:          1,
 			SendQueueCapacity: 10,
 		},
+		{
+			ID:                PexObservedAddrChannel,
+			Priority:          1,
+			SendQueueCapacity: 1,
+		},
 	}
 }
 
@@ -165,6 +209,11 @@ func (r *PEXReactor) AddPeer(p Peer) {
 		if r.book.NeedMoreAddrs() {
 			r.RequestAddrs(p)
 		}
+		// Only outbound peers, which we picked ourselves, are asked which
+		// address they see us on.
+		if r.config.DiscoverExternalAddr {
+			r.requestObservedAddr(p)
+		}
 	} else {
 		// inbound peer is its own source
 		addr := p.NodeInfo().NetAddress()
@@ -194,6 +243,9 @@ func (r *PEXReactor) RemovePeer(p Peer, reason interface{}) {
 	id := string(p.ID())
 	r.requestsSent.Delete(id)
 	r.lastReceivedRequests.Delete(id)
+	r.observedAddrRequestsSent.Delete(id)
+	r.observedAddrRequestsReceived.Delete(id)
+	r.observedAddrs.remove(p.ID())
 }
 
 // Receive implements Reactor by handling incoming PEX messages.
@@ -206,6 +258,18 @@ func (r *PEXReactor) Receive(chID byte, src Peer, msgBytes []byte) {
 	}
 	r.Logger.Debug("Received message", "src", src, "chId", chID, "msg", msg)
 
+	if !r.IsPeerPEXEnabled(src.ID()) {
+		r.Logger.Debug("Ignoring message from peer with PEX disabled", "src", src)
+		return
+	}
+
+	if chID == PexObservedAddrChannel {
+		if err := r.receiveObservedAddrMsg(src, msg); err != nil {
+			r.Switch.StopPeerForError(src, err)
+		}
+		return
+	}
+
 	switch msg := msg.(type) {
 	case *pexRequestMessage:
 
@@ -226,8 +290,8 @@ func (r *PEXReactor) Receive(chID byte, src Peer, msgBytes []byte) {
 			}
 			r.lastReceivedRequests.Set(id, time.Now())
 
-			// Send addrs and disconnect
-			r.SendAddrs(src, r.book.GetSelectionWithBias(biasToSelectNewPeers))
+			// Send recently reachable addrs and disconnect
+			r.SendAddrs(src, r.crawler.reachableAddrs(time.Now(), maxGetSelection))
 			go func() {
 				// In a go-routine so it doesn't block .Receive.
 				src.FlushStop()
@@ -287,12 +351,31 @@ func (r *PEXReactor) receiveRequest(src Peer) error {
 	return nil
 }
 
+// SetPeerPEXEnabled enables or disables the exchange of addresses with the
+// peer with the given ID. While disabled, we neither request addresses from
+// the peer nor handle its PEX messages.
+func (r *PEXReactor) SetPeerPEXEnabled(id p2p.ID, enabled bool) {
+	r.Logger.Info("Set peer PEX", "id", id, "enabled", enabled)
+	if enabled {
+		r.disabledPeers.Delete(string(id))
+	} else {
+		r.disabledPeers.Set(string(id), struct{}{})
+		r.requestsSent.Delete(string(id))
+	}
+}
+
+// IsPeerPEXEnabled returns true if we exchange addresses with the peer with
+// the given ID.
+func (r *PEXReactor) IsPeerPEXEnabled(id p2p.ID) bool {
+	return !r.disabledPeers.Has(string(id))
+}
+
 // RequestAddrs asks peer for more addresses if we do not already
 // have a request out for this peer.
 func (r *PEXReactor) RequestAddrs(p Peer) {
 	r.Logger.Debug("Request addrs", "from", p)
 	id := string(p.ID())
-	if r.requestsSent.Has(id) {
+	if r.requestsSent.Has(id) || !r.IsPeerPEXEnabled(p.ID()) {
 		return
 	}
 	r.requestsSent.Set(id, struct{}{})
@@ -334,6 +417,12 @@ func (r *PEXReactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 			continue
 		}
 
+		// The crawler keeps track of the addresses the book accepted,
+		// regardless of whether they fit in it.
+		if r.crawler != nil {
+			r.crawler.addAddress(na)
+		}
+
 		// If this address came from a seed node, try to connect to it without
 		// waiting.
 		for _, seedAddr := range r.seedAddrs {
@@ -345,6 +434,64 @@ func (r *PEXReactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 	return nil
 }
 
+// requestObservedAddr asks the peer which address it sees us on.
+func (r *PEXReactor) requestObservedAddr(p Peer) {
+	id := string(p.ID())
+	if r.observedAddrRequestsSent.Has(id) {
+		return
+	}
+	if p.Send(PexObservedAddrChannel, cdc.MustMarshalBinaryBare(&pexObservedAddrRequestMessage{})) {
+		r.observedAddrRequestsSent.Set(id, struct{}{})
+	}
+}
+
+// receiveObservedAddrMsg answers a peer's request for the address we see it
+// on, once per connection, and tallies the answers to our own requests.
+func (r *PEXReactor) receiveObservedAddrMsg(src Peer, msg PexMessage) error {
+	id := string(src.ID())
+	switch msg := msg.(type) {
+	case *pexObservedAddrRequestMessage:
+		if r.observedAddrRequestsReceived.Has(id) {
+			return errors.New("Duplicate pexObservedAddrRequestMessage")
+		}
+		r.observedAddrRequestsReceived.Set(id, struct{}{})
+		src.Send(PexObservedAddrChannel, cdc.MustMarshalBinaryBare(&pexObservedAddrMessage{IP: src.RemoteIP()}))
+
+	case *pexObservedAddrMessage:
+		if !r.observedAddrRequestsSent.Has(id) {
+			return errors.New("Unsolicited pexObservedAddrMessage")
+		}
+		r.observedAddrRequestsSent.Delete(id)
+		r.receiveObservedAddr(msg.IP, src)
+
+	default:
+		return fmt.Errorf("Unknown message type %v on observed address channel", reflect.TypeOf(msg))
+	}
+	return nil
+}
+
+// receiveObservedAddr records the IP the peer sees us on, and starts
+// advertising it once enough peers agree.
+func (r *PEXReactor) receiveObservedAddr(ip net.IP, src Peer) {
+	ourAddr := r.Switch.NodeInfo().NetAddress()
+	addr := p2p.NewNetAddressIPPort(ip, ourAddr.Port)
+	addr.ID = ourAddr.ID
+	addr.Protocol = ourAddr.Protocol
+	if !addr.Routable() {
+		r.Logger.Debug("Ignoring unroutable observed address", "addr", addr, "src", src)
+		return
+	}
+	group := networkGroupKey(p2p.NewNetAddressIPPort(src.RemoteIP(), 0))
+	if !r.observedAddrs.add(src.ID(), group, ip) {
+		return
+	}
+
+	r.Logger.Info("Discovered external address", "addr", addr)
+	if err := r.Switch.SetExternalAddress(addr); err != nil {
+		r.Logger.Error("Failed to set external address", "addr", addr, "err", err)
+	}
+}
+
 // SendAddrs sends addrs to the peer.
 func (r *PEXReactor) SendAddrs(p Peer, netAddrs []*p2p.NetAddress) {
 	p.Send(PexChannel, cdc.MustMarshalBinaryBare(&pexAddrsMessage{Addrs: netAddrs}))
@@ -442,7 +589,12 @@ func (r *PEXReactor) ensurePeers() {
 
 	// If we need more addresses, pick a random peer and ask for more.
 	if r.book.NeedMoreAddrs() {
-		peers := r.Switch.Peers().List()
+		peers := make([]Peer, 0, r.Switch.Peers().Size())
+		for _, peer := range r.Switch.Peers().List() {
+			if r.IsPeerPEXEnabled(peer.ID()) {
+				peers = append(peers, peer)
+			}
+		}
 		peersCount := len(peers)
 		if peersCount > 0 {
 			peer := peers[cmn.RandInt()%peersCount] // nolint: gas
@@ -476,7 +628,8 @@ func (r *PEXReactor) dialPeer(addr *p2p.NetAddress) {
 			r.Logger.Info("Reached max attempts to dial", "addr", addr, "attempts", attempts)
 			r.attemptsToDial.Store(addr.DialString(), _attemptsToDial{attempts + 1, time.Now()})
 		}
-		r.book.MarkBad(addr)
+		// the address may just be unreachable for now, so it isn't banned
+		r.book.RemoveAddress(addr)
 		return
 	}
 
@@ -496,7 +649,7 @@ func (r *PEXReactor) dialPeer(addr *p2p.NetAddress) {
 		r.Logger.Error("Dialing failed", "addr", addr, "err", err, "attempts", attempts)
 		// TODO: detect more "bad peer" scenarios
 		if _, ok := err.(p2p.ErrSwitchAuthenticationFailure); ok {
-			r.book.MarkBad(addr)
+			r.book.MarkBad(addr, err.Error())
 			r.attemptsToDial.Delete(addr.DialString())
 		} else {
 			r.book.MarkAttempt(addr)
@@ -578,6 +731,7 @@ func (r *PEXReactor) crawlPeersRoutine() {
 		case <-ticker.C:
 			r.attemptDisconnects()
 			r.crawlPeers()
+			r.saveCrawlRecords()
 		case <-r.Quit():
 			return
 		}
@@ -613,26 +767,13 @@ func (of oldestFirst) Len() int           { return len(of) }
 func (of oldestFirst) Swap(i, j int)      { of[i], of[j] = of[j], of[i] }
 func (of oldestFirst) Less(i, j int) bool { return of[i].LastAttempt.Before(of[j].LastAttempt) }
 
-// getPeersToCrawl returns addresses of potential peers that we wish to validate.
-// NOTE: The status information is ordered as described above.
+// getPeersToCrawl hands the addresses of the address book over to the crawler
+// and returns every address it tracks, least recently attempted first.
 func (r *PEXReactor) getPeersToCrawl() []crawlPeerInfo {
-	var of oldestFirst
-
-	// TODO: be more selective
-	addrs := r.book.ListOfKnownAddresses()
-	for _, addr := range addrs {
-		if len(addr.ID()) == 0 {
-			continue // dont use peers without id
-		}
-
-		of = append(of, crawlPeerInfo{
-			Addr:        addr.Addr,
-			LastAttempt: addr.LastAttempt,
-			LastSuccess: addr.LastSuccess,
-		})
+	for _, addr := range r.book.ListOfKnownAddresses() {
+		r.crawler.addAddress(addr.Addr) // dont use peers without id
 	}
-	sort.Sort(of)
-	return of
+	return r.crawler.peersToCrawl()
 }
 
 // crawlPeers will crawl the network looking for new peer addresses. (once)
@@ -642,31 +783,69 @@ func (r *PEXReactor) crawlPeers() {
 	now := time.Now()
 	// Use addresses we know of to reach additional peers
 	for _, pi := range peerInfos {
+		// Forget the addresses banned since they were learned
+		if r.book.IsBanned(pi.Addr.ID) {
+			r.crawler.removeAddress(pi.Addr.ID)
+			continue
+		}
 		// Do not attempt to connect with peers we recently dialed
 		if now.Sub(pi.LastAttempt) < defaultCrawlPeerInterval {
 			continue
 		}
+		// Do not dial peers we're already connected to, they are reachable
+		if peer := r.Switch.Peers().Get(pi.Addr.ID); peer != nil {
+			r.crawler.recordAttempt(pi.Addr, now, 0, nil, peer.NodeInfo())
+			r.RequestAddrs(peer)
+			continue
+		}
 		// Otherwise, attempt to connect with the known address
+		start := time.Now()
 		err := r.Switch.DialPeerWithAddress(pi.Addr, false)
+		latency := time.Since(start)
 		if err != nil {
+			r.crawler.recordAttempt(pi.Addr, start, latency, err, nil)
 			r.book.MarkAttempt(pi.Addr)
 			continue
 		}
-		// Ask for more addresses
 		peer := r.Switch.Peers().Get(pi.Addr.ID)
-		if peer != nil {
-			r.RequestAddrs(peer)
+		if peer == nil {
+			r.crawler.recordAttempt(pi.Addr, start, latency, nil, nil)
+			continue
 		}
+		r.crawler.recordAttempt(pi.Addr, start, latency, nil, peer.NodeInfo())
+		// Ask for more addresses
+		r.RequestAddrs(peer)
 	}
 }
 
-// attemptDisconnects checks if we've been with each peer long enough to disconnect
+func (r *PEXReactor) saveCrawlRecords() {
+	if r.config.CrawlFile == "" {
+		return
+	}
+	if err := r.crawler.saveToFile(r.config.CrawlFile); err != nil {
+		r.Logger.Error("Failed to save crawl records", "file", r.config.CrawlFile, "err", err)
+	}
+}
+
+// CrawlReport returns what the crawler knows about every address it has
+// discovered, most reachable first. It is only available in seed/crawler
+// mode.
+func (r *PEXReactor) CrawlReport() ([]CrawlRecord, error) {
+	if r.crawler == nil {
+		return nil, errors.New("crawl report is only available in seed mode")
+	}
+	return r.crawler.report(), nil
+}
+
+// attemptDisconnects checks if we've been with each peer long enough to disconnect.
+// Persistent and unconditional peers are never disconnected.
 func (r *PEXReactor) attemptDisconnects() {
 	for _, peer := range r.Switch.Peers().List() {
 		if peer.Status().Duration < defaultSeedDisconnectWaitPeriod {
 			continue
 		}
-		if peer.IsPersistent() {
+		if r.Switch.IsPeerPersistent(peer.ID()) ||
+			r.Switch.IsPeerUnconditional(peer.ID()) {
 			continue
 		}
 		r.Switch.StopPeerGracefully(peer)
@@ -684,6 +863,8 @@ func RegisterPexMessage(cdc *amino.Codec) {
 	cdc.RegisterInterface((*PexMessage)(nil), nil)
 	cdc.RegisterConcrete(&pexRequestMessage{}, "tendermint/p2p/PexRequestMessage", nil)
 	cdc.RegisterConcrete(&pexAddrsMessage{}, "tendermint/p2p/PexAddrsMessage", nil)
+	cdc.RegisterConcrete(&pexObservedAddrRequestMessage{}, "tendermint/p2p/PexObservedAddrRequestMessage", nil)
+	cdc.RegisterConcrete(&pexObservedAddrMessage{}, "tendermint/p2p/PexObservedAddrMessage", nil)
 }
 
 func decodeMsg(bz []byte) (msg PexMessage, err error) {
@@ -714,3 +895,24 @@ type pexAddrsMessage struct {
 func (m *pexAddrsMessage) String() string {
 	return fmt.Sprintf("[pexAddrs %v]", m.Addrs)
 }
+
+/*
+A pexObservedAddrRequestMessage asks a peer which IP it sees us on.
+*/
+type pexObservedAddrRequestMessage struct {
+}
+
+func (m *pexObservedAddrRequestMessage) String() string {
+	return "[pexObservedAddrRequest]"
+}
+
+/*
+A message with the IP the sender sees the receiver on.
+*/
+type pexObservedAddrMessage struct {
+	IP net.IP
+}
+
+func (m *pexObservedAddrMessage) String() string {
+	return fmt.Sprintf("[pexObservedAddr %v]", m.IP)
+}
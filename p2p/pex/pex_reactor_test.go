@@ -202,6 +202,124 @@ func TestPEXReactorAddrsMessageAbuse(t *testing.T) {
 	assert.False(t, sw.Peers().Has(peer.ID()))
 }
 
+func TestPEXReactorPeerPEXDisabled(t *testing.T) {
+	r, book := createReactor(&PEXReactorConfig{})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(r)
+	sw.SetAddrBook(book)
+
+	peer := newMockPeer()
+	p2p.AddPeerToSwitch(sw, peer)
+	id := string(peer.ID())
+
+	r.SetPeerPEXEnabled(peer.ID(), false)
+	assert.False(t, r.IsPeerPEXEnabled(peer.ID()))
+
+	// no requests are sent to the peer
+	r.RequestAddrs(peer)
+	assert.False(t, r.requestsSent.Has(id))
+
+	// and its messages are ignored
+	size := book.Size()
+	addrs := []*p2p.NetAddress{peer.NodeInfo().NetAddress()}
+	msg := cdc.MustMarshalBinaryBare(&pexAddrsMessage{Addrs: addrs})
+	r.Receive(PexChannel, peer, msg)
+	assert.Equal(t, size, book.Size())
+	assert.True(t, sw.Peers().Has(peer.ID()))
+
+	r.SetPeerPEXEnabled(peer.ID(), true)
+	assert.True(t, r.IsPeerPEXEnabled(peer.ID()))
+	r.RequestAddrs(peer)
+	assert.True(t, r.requestsSent.Has(id))
+}
+
+func TestPEXReactorDiscoversExternalAddr(t *testing.T) {
+	r, book := createReactor(&PEXReactorConfig{DiscoverExternalAddr: true})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(r)
+	sw.SetAddrBook(book)
+	listenAddr := sw.NodeInfo().NetAddress()
+
+	ip := net.ParseIP("1.2.3.4")
+	msg := cdc.MustMarshalBinaryBare(&pexObservedAddrMessage{IP: ip})
+	for i := 0; i < minObservedAddrReports; i++ {
+		assert.Equal(t, listenAddr, sw.NodeInfo().NetAddress(), "not enough peers agree yet")
+
+		// each peer in a network of its own
+		peer := newMockPeer()
+		peer.addr.IP = net.IPv4(byte(10+i), 0, 0, 1)
+		p2p.AddPeerToSwitch(sw, peer)
+		r.observedAddrRequestsSent.Set(string(peer.ID()), struct{}{})
+		r.Receive(PexObservedAddrChannel, peer, msg)
+		assert.True(t, sw.Peers().Has(peer.ID()))
+	}
+
+	externalAddr := sw.NodeInfo().NetAddress()
+	assert.Equal(t, ip.String(), externalAddr.IP.String())
+	assert.Equal(t, listenAddr.Port, externalAddr.Port)
+	assert.Equal(t, listenAddr.ID, externalAddr.ID)
+	assert.True(t, book.OurAddress(externalAddr))
+
+	// unsolicited answers get the peer disconnected
+	peer := newMockPeer()
+	p2p.AddPeerToSwitch(sw, peer)
+	r.Receive(PexObservedAddrChannel, peer, msg)
+	assert.False(t, sw.Peers().Has(peer.ID()))
+}
+
+func TestObservedAddrsNeedAgreeingPeers(t *testing.T) {
+	oa := newObservedAddrs()
+	ip1, ip2 := net.ParseIP("1.2.3.4"), net.ParseIP("5.6.7.8")
+	peers := make([]p2p.ID, minObservedAddrReports)
+	groups := make([]string, minObservedAddrReports)
+	for i := range peers {
+		peers[i] = newMockPeer().ID()
+		groups[i] = fmt.Sprintf("%d.0.0.0/16", 10+i)
+	}
+
+	// a peer changing its report only counts once
+	for i := 0; i < minObservedAddrReports; i++ {
+		assert.False(t, oa.add(peers[0], groups[0], ip1))
+	}
+	for i, id := range peers[1 : len(peers)-1] {
+		assert.False(t, oa.add(id, groups[i+1], ip1))
+	}
+	// disconnected peers' reports are forgotten
+	oa.remove(peers[1])
+	assert.False(t, oa.add(peers[len(peers)-1], groups[len(peers)-1], ip1))
+	assert.True(t, oa.add(peers[1], groups[1], ip1))
+	// the adopted address is only reported once
+	assert.False(t, oa.add(peers[1], groups[1], ip1))
+
+	for i, id := range peers[:len(peers)-1] {
+		assert.False(t, oa.add(id, groups[i], ip2))
+	}
+	assert.True(t, oa.add(peers[len(peers)-1], groups[len(peers)-1], ip2))
+}
+
+func TestObservedAddrsNeedMajorityOfNetworks(t *testing.T) {
+	oa := newObservedAddrs()
+	honest, attacker := net.ParseIP("1.2.3.4"), net.ParseIP("5.6.7.8")
+
+	// peers in the same network only count once
+	for i := 0; i < minObservedAddrReports; i++ {
+		assert.False(t, oa.add(newMockPeer().ID(), "66.0.0.0/16", attacker))
+	}
+
+	for i := 0; i < minObservedAddrReports; i++ {
+		adopted := oa.add(newMockPeer().ID(), fmt.Sprintf("%d.0.0.0/16", 10+i), honest)
+		assert.Equal(t, i == minObservedAddrReports-1, adopted)
+	}
+
+	// enough networks agreeing on another address isn't enough without a
+	// majority
+	for i := 1; i < minObservedAddrReports; i++ {
+		assert.False(t, oa.add(newMockPeer().ID(), fmt.Sprintf("%d.0.0.0/16", 66+i), attacker))
+	}
+}
+
 func TestCheckSeeds(t *testing.T) {
 	// directory to store address books
 	dir, err := ioutil.TempDir("", "pex_reactor")
@@ -316,6 +434,52 @@ func TestPEXReactorCrawlStatus(t *testing.T) {
 	// TODO: test
 }
 
+func TestPEXReactorCrawlsOnlyAcceptedAddrs(t *testing.T) {
+	pexR, book := createReactor(&PEXReactorConfig{SeedMode: true})
+	defer teardownReactor(book)
+
+	peer := p2p.CreateRandomPeer(false)
+	pexR.RequestAddrs(peer)
+
+	_, routable := p2p.CreateRoutableAddr()
+	_, other := p2p.CreateRoutableAddr()
+	unroutable, err := p2p.NewNetAddressString(fmt.Sprintf("%v@127.0.0.1:26656", other.ID))
+	require.NoError(t, err)
+	_, banned := p2p.CreateRoutableAddr()
+	book.Ban(banned.ID, 0, "misbehaving")
+
+	// only the addresses accepted by the book are crawled
+	addrs := []*p2p.NetAddress{routable, unroutable, banned}
+	require.NoError(t, pexR.ReceiveAddrs(addrs, peer))
+
+	report, err := pexR.CrawlReport()
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, routable.ID, report[0].Addr.ID)
+}
+
+func TestPEXReactorCrawlRecordsConnectedPeers(t *testing.T) {
+	pexR, book := createReactor(&PEXReactorConfig{SeedMode: true})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(pexR)
+	sw.SetAddrBook(book)
+
+	peer := p2p.CreateRandomPeer(false)
+	p2p.AddPeerToSwitch(pexR.Switch, peer)
+	addr := peer.NodeInfo().NetAddress()
+	require.NoError(t, book.AddAddress(addr, addr))
+
+	pexR.crawlPeers()
+
+	report, err := pexR.CrawlReport()
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, 1.0, report[0].Reachability())
+	assert.False(t, report[0].LastSuccess.IsZero())
+	assert.Zero(t, report[0].Latency(), "no dial latency is measured")
+}
+
 func TestPEXReactorDoesNotAddPrivatePeersToAddrBook(t *testing.T) {
 	peer := p2p.CreateRandomPeer(false)
 
@@ -369,6 +533,24 @@ func TestPEXReactorDialPeer(t *testing.T) {
 	}
 }
 
+func TestPEXReactorDialPeerMaxAttempts(t *testing.T) {
+	pexR, book := createReactor(&PEXReactorConfig{})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(pexR)
+	sw.SetAddrBook(book)
+
+	peer := newMockPeer()
+	addr := peer.NodeInfo().NetAddress()
+	require.NoError(t, book.AddAddress(addr, addr))
+	pexR.attemptsToDial.Store(addr.DialString(), _attemptsToDial{maxAttemptsToDial + 1, time.Now()})
+
+	// an address which couldn't be dialed is removed, but not banned
+	pexR.dialPeer(addr)
+	assert.False(t, book.HasAddress(addr))
+	assert.False(t, book.IsBanned(addr.ID))
+}
+
 type mockPeer struct {
 	*cmn.BaseService
 	pubKey               crypto.PubKey
@@ -397,7 +579,7 @@ func (mp mockPeer) NodeInfo() p2p.NodeInfo {
 		ListenAddr: mp.addr.DialString(),
 	}
 }
-func (mockPeer) RemoteIP() net.IP              { return net.ParseIP("127.0.0.1") }
+func (mp mockPeer) RemoteIP() net.IP           { return mp.addr.IP }
 func (mockPeer) Status() conn.ConnectionStatus { return conn.ConnectionStatus{} }
 func (mockPeer) Send(byte, []byte) bool        { return false }
 func (mockPeer) TrySend(byte, []byte) bool     { return false }
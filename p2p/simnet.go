@@ -0,0 +1,668 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SimLink describes the characteristics of the link between two hosts of a
+// SimNetwork.
+type SimLink struct {
+	// One-way delay of data sent over the link.
+	Latency time.Duration
+
+	// Capacity of the link in bytes per second. 0 means unlimited.
+	Bandwidth int64
+
+	// Maximum random delay added to the latency of each write. The delays are
+	// drawn from the network's seed, see SimNetworkSeed.
+	Jitter time.Duration
+}
+
+//-----------------------------------------------------------------------------
+
+// SimClock is the source of time of a SimNetwork, which determines when data
+// is delivered, how long writes block and when timed partitions heal.
+type SimClock interface {
+	Now() time.Time
+	// After returns a channel on which the time is sent once d has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+type simWallClock struct{}
+
+func (simWallClock) Now() time.Time                         { return time.Now() }
+func (simWallClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SimManualClock is a SimClock which only moves when advanced, so that a
+// test controls exactly when data sent over a SimNetwork is delivered.
+type SimManualClock struct {
+	mtx    sync.Mutex
+	now    time.Time
+	timers []simClockTimer // sorted by deadline
+}
+
+type simClockTimer struct {
+	deadline time.Time
+	c        chan time.Time
+}
+
+var _ SimClock = (*SimManualClock)(nil)
+
+// NewSimManualClock returns a SimManualClock set to the given time.
+func NewSimManualClock(now time.Time) *SimManualClock {
+	return &SimManualClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *SimManualClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.now
+}
+
+// After returns a channel on which the time is sent once the clock has been
+// advanced by d.
+func (c *SimManualClock) After(d time.Duration) <-chan time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	timer := simClockTimer{deadline: c.now.Add(d), c: ch}
+	i := sort.Search(len(c.timers), func(i int) bool {
+		return c.timers[i].deadline.After(timer.deadline)
+	})
+	c.timers = append(c.timers, simClockTimer{})
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = timer
+	return ch
+}
+
+// Advance moves the clock forward by d, firing the timers which expire in
+// the order of their deadlines.
+func (c *SimManualClock) Advance(d time.Duration) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.now = c.now.Add(d)
+	for len(c.timers) > 0 && !c.timers[0].deadline.After(c.now) {
+		c.timers[0].c <- c.timers[0].deadline
+		c.timers = c.timers[1:]
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// SimNetwork is an in-memory network for testing, over which
+// MultiplexTransports can listen for and dial connections (see
+// MultiplexTransportNetwork). Hosts are identified by their IP address.
+//
+// Links between hosts can be given a latency and bandwidth, and hosts can be
+// partitioned into groups which can't reach each other. Data sent across a
+// partition is held back until the partition heals, as TCP would retransmit
+// it, so connections only break if the peers' ping/pong timeouts expire.
+//
+// All timing follows the network's SimClock and all randomness comes from its
+// seed, so with a SimManualClock the network delivers the same data at the
+// same (virtual) times on every run with the same seed and sequence of dials
+// and writes. Read and write deadlines are still wall clock times, as set by
+// the users of a net.Conn.
+type SimNetwork struct {
+	mtx sync.Mutex
+
+	clock       SimClock
+	seed        int64
+	listeners   map[string]*simListener // by dial string
+	links       map[simLinkKey]SimLink
+	defaultLink SimLink
+	groups      map[string]int // host -> partition group, nil if not partitioned
+	pipes       map[*simPipe]struct{}
+	numPipes    int64 // pipes created so far, seeds the jitter of each pipe
+	nextPort    uint16
+	closed      bool
+}
+
+// SimNetworkOption sets an optional parameter on the SimNetwork.
+type SimNetworkOption func(*SimNetwork)
+
+// SimNetworkClock sets the clock of the network. Defaults to the wall clock.
+func SimNetworkClock(clock SimClock) SimNetworkOption {
+	return func(sn *SimNetwork) { sn.clock = clock }
+}
+
+// SimNetworkSeed sets the seed from which the random link jitter is drawn.
+// Defaults to 0.
+func SimNetworkSeed(seed int64) SimNetworkOption {
+	return func(sn *SimNetwork) { sn.seed = seed }
+}
+
+type simLinkKey struct {
+	a, b string
+}
+
+func newSimLinkKey(a, b string) simLinkKey {
+	if a > b {
+		a, b = b, a
+	}
+	return simLinkKey{a, b}
+}
+
+// NewSimNetwork returns a SimNetwork with no latency, unlimited bandwidth and
+// no partitions.
+func NewSimNetwork(options ...SimNetworkOption) *SimNetwork {
+	sn := &SimNetwork{
+		clock:     simWallClock{},
+		listeners: make(map[string]*simListener),
+		links:     make(map[simLinkKey]SimLink),
+		pipes:     make(map[*simPipe]struct{}),
+		nextPort:  40000,
+	}
+	for _, option := range options {
+		option(sn)
+	}
+	return sn
+}
+
+// Close closes all connections of the network. Blocked reads and writes
+// fail, and no more connections can be made. Listeners are left to be closed
+// by the transports owning them, which would otherwise take the closed
+// listener for a failure.
+func (sn *SimNetwork) Close() {
+	sn.mtx.Lock()
+	sn.closed = true
+	pipes := make([]*simPipe, 0, len(sn.pipes))
+	for p := range sn.pipes {
+		pipes = append(pipes, p)
+	}
+	sn.pipes = make(map[*simPipe]struct{})
+	sn.mtx.Unlock()
+
+	for _, p := range pipes {
+		p.closeReader()
+		p.closeWriter()
+	}
+}
+
+// Host returns the TransportNetwork used by the host with the given IP.
+func (sn *SimNetwork) Host(ip net.IP) TransportNetwork {
+	return &simHost{network: sn, ip: ip}
+}
+
+// SetDefaultLink sets the characteristics of links for which none were set
+// with SetLink. It only affects data sent from then on.
+func (sn *SimNetwork) SetDefaultLink(link SimLink) {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	sn.defaultLink = link
+}
+
+// SetLink sets the characteristics of the link between hosts a and b, in both
+// directions. It only affects data sent from then on.
+func (sn *SimNetwork) SetLink(a, b net.IP, link SimLink) {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	sn.links[newSimLinkKey(a.String(), b.String())] = link
+}
+
+// Partition splits the network into the given groups of hosts: hosts in
+// different groups can't dial or exchange data with each other. Hosts not in
+// any group form a group of their own. Any previous partition is replaced.
+func (sn *SimNetwork) Partition(groups ...[]net.IP) {
+	sn.mtx.Lock()
+	sn.groups = make(map[string]int)
+	for i, group := range groups {
+		for _, ip := range group {
+			sn.groups[ip.String()] = i
+		}
+	}
+	sn.mtx.Unlock()
+
+	sn.notifyPipes()
+}
+
+// PartitionFor partitions the network like Partition, and heals it once the
+// given duration has passed.
+func (sn *SimNetwork) PartitionFor(d time.Duration, groups ...[]net.IP) {
+	sn.Partition(groups...)
+	healc := sn.clock.After(d)
+	go func() {
+		<-healc
+		sn.Heal()
+	}()
+}
+
+// Heal removes any partition, delivering the data held back by it.
+func (sn *SimNetwork) Heal() {
+	sn.mtx.Lock()
+	sn.groups = nil
+	sn.mtx.Unlock()
+
+	sn.notifyPipes()
+}
+
+func (sn *SimNetwork) notifyPipes() {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	for p := range sn.pipes {
+		p.notify()
+	}
+}
+
+func (sn *SimNetwork) link(a, b string) SimLink {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	if link, ok := sn.links[newSimLinkKey(a, b)]; ok {
+		return link
+	}
+	return sn.defaultLink
+}
+
+func (sn *SimNetwork) connected(a, b string) bool {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	return sn.connectedLocked(a, b)
+}
+
+func (sn *SimNetwork) connectedLocked(a, b string) bool {
+	if sn.groups == nil {
+		return true
+	}
+	groupA, ok := sn.groups[a]
+	if !ok {
+		groupA = -1
+	}
+	groupB, ok := sn.groups[b]
+	if !ok {
+		groupB = -1
+	}
+	return groupA == groupB
+}
+
+// newPipeLocked creates a pipe from src to dst and tracks it until it's
+// closed on both ends. Each pipe draws its jitter from its own source, seeded
+// by the order in which pipes are created, so that the jitter doesn't depend
+// on the order in which concurrent connections write.
+func (sn *SimNetwork) newPipeLocked(src, dst string) *simPipe {
+	p := &simPipe{
+		network: sn,
+		src:     src,
+		dst:     dst,
+		rand:    rand.New(rand.NewSource(sn.seed + sn.numPipes)),
+		notifyc: make(chan struct{}, 1),
+	}
+	sn.numPipes++
+	sn.pipes[p] = struct{}{}
+	return p
+}
+
+func (sn *SimNetwork) removePipe(p *simPipe) {
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+	delete(sn.pipes, p)
+}
+
+//-----------------------------------------------------------------------------
+
+// simHost implements TransportNetwork for a single host of a SimNetwork.
+type simHost struct {
+	network *SimNetwork
+	ip      net.IP
+}
+
+var _ TransportNetwork = (*simHost)(nil)
+
+func (h *simHost) Listen(addr NetAddress) (net.Listener, error) {
+	sn := h.network
+	sn.mtx.Lock()
+	defer sn.mtx.Unlock()
+
+	if sn.closed {
+		return nil, errors.New("network closed")
+	}
+	key := addr.DialString()
+	if _, ok := sn.listeners[key]; ok {
+		return nil, fmt.Errorf("address %v already in use", key)
+	}
+	l := &simListener{
+		network: sn,
+		key:     key,
+		addr:    &net.TCPAddr{IP: addr.IP, Port: int(addr.Port)},
+		acceptc: make(chan net.Conn),
+		closec:  make(chan struct{}),
+	}
+	sn.listeners[key] = l
+	return l, nil
+}
+
+func (h *simHost) Dial(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	sn := h.network
+	sn.mtx.Lock()
+	l, ok := sn.listeners[addr.DialString()]
+	if !ok || sn.closed {
+		sn.mtx.Unlock()
+		return nil, fmt.Errorf("dial %v: connection refused", addr.DialString())
+	}
+	if !sn.connectedLocked(h.ip.String(), addr.IP.String()) {
+		timeoutc := sn.clock.After(timeout)
+		sn.mtx.Unlock()
+		// the host is unreachable, so the dial can only time out
+		<-timeoutc
+		return nil, simTimeoutError{}
+	}
+	local := &net.TCPAddr{IP: h.ip, Port: int(sn.nextPort)}
+	sn.nextPort++
+
+	dialerConn, listenerConn := newSimConnPair(sn, local, l.addr)
+	timeoutc := sn.clock.After(timeout)
+	sn.mtx.Unlock()
+
+	select {
+	case l.acceptc <- listenerConn:
+		return dialerConn, nil
+	case <-l.closec:
+	case <-timeoutc:
+	}
+	_ = dialerConn.Close()
+	_ = listenerConn.Close()
+	return nil, fmt.Errorf("dial %v: connection refused", addr.DialString())
+}
+
+// simListener implements net.Listener for a SimNetwork.
+type simListener struct {
+	network *SimNetwork
+	key     string
+	addr    *net.TCPAddr
+
+	acceptc   chan net.Conn
+	closec    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*simListener)(nil)
+
+func (l *simListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.acceptc:
+		return c, nil
+	case <-l.closec:
+		return nil, errors.New("listener closed")
+	}
+}
+
+func (l *simListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closec)
+		l.network.mtx.Lock()
+		delete(l.network.listeners, l.key)
+		l.network.mtx.Unlock()
+	})
+	return nil
+}
+
+func (l *simListener) Addr() net.Addr {
+	return l.addr
+}
+
+//-----------------------------------------------------------------------------
+
+// simConn implements net.Conn for a SimNetwork. It reads from one pipe and
+// writes to the other, which its remote end reads from.
+type simConn struct {
+	network       *SimNetwork
+	local, remote *net.TCPAddr
+	in, out       *simPipe
+
+	mtx           sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+var _ net.Conn = (*simConn)(nil)
+
+// newSimConnPair must be called with sn.mtx held.
+func newSimConnPair(sn *SimNetwork, a, b *net.TCPAddr) (*simConn, *simConn) {
+	ab := sn.newPipeLocked(a.IP.String(), b.IP.String())
+	ba := sn.newPipeLocked(b.IP.String(), a.IP.String())
+	return &simConn{network: sn, local: a, remote: b, in: ba, out: ab},
+		&simConn{network: sn, local: b, remote: a, in: ab, out: ba}
+}
+
+func (c *simConn) Read(b []byte) (int, error) {
+	return c.in.read(b, c.getReadDeadline)
+}
+
+func (c *simConn) Write(b []byte) (int, error) {
+	c.mtx.Lock()
+	deadline := c.writeDeadline
+	c.mtx.Unlock()
+	if !deadline.IsZero() && !time.Now().Before(deadline) {
+		return 0, simTimeoutError{}
+	}
+	return c.out.write(b)
+}
+
+// Close closes both directions of the connection: pending reads fail, and the
+// remote end reads EOF once it has received all data written so far. A pipe
+// is released once it's closed on both ends.
+func (c *simConn) Close() error {
+	c.in.closeReader()
+	c.out.closeWriter()
+	return nil
+}
+
+func (c *simConn) LocalAddr() net.Addr  { return c.local }
+func (c *simConn) RemoteAddr() net.Addr { return c.remote }
+
+func (c *simConn) SetDeadline(t time.Time) error {
+	c.mtx.Lock()
+	c.readDeadline = t
+	c.writeDeadline = t
+	c.mtx.Unlock()
+	c.in.notify()
+	return nil
+}
+
+func (c *simConn) SetReadDeadline(t time.Time) error {
+	c.mtx.Lock()
+	c.readDeadline = t
+	c.mtx.Unlock()
+	c.in.notify()
+	return nil
+}
+
+func (c *simConn) SetWriteDeadline(t time.Time) error {
+	c.mtx.Lock()
+	c.writeDeadline = t
+	c.mtx.Unlock()
+	return nil
+}
+
+func (c *simConn) getReadDeadline() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.readDeadline
+}
+
+// simChunk is a write to a simPipe, readable once the network's clock has
+// reached deliverAt.
+type simChunk struct {
+	data      []byte
+	deliverAt time.Time
+}
+
+// simPipe is one direction of a simConn, carrying data from host src to host
+// dst with the latency and bandwidth of the link between them.
+type simPipe struct {
+	network  *SimNetwork
+	src, dst string
+	rand     *rand.Rand // guarded by mtx
+
+	mtx          sync.Mutex
+	chunks       []simChunk
+	pending      []byte    // remainder of a partially read chunk
+	sendDone     time.Time // when the link is done transmitting queued data
+	lastDeliver  time.Time
+	writerClosed bool
+	readerClosed bool
+
+	notifyc chan struct{}
+}
+
+// notify wakes up a blocked reader, if any.
+func (p *simPipe) notify() {
+	select {
+	case p.notifyc <- struct{}{}:
+	default:
+	}
+}
+
+func (p *simPipe) write(b []byte) (int, error) {
+	link := p.network.link(p.src, p.dst)
+
+	p.mtx.Lock()
+	if p.writerClosed || p.readerClosed {
+		p.mtx.Unlock()
+		return 0, io.ErrClosedPipe
+	}
+
+	// Data is transmitted at the link's bandwidth after any data queued
+	// before it, then takes the link's latency to arrive.
+	now := p.network.clock.Now()
+	start := p.sendDone
+	if start.Before(now) {
+		start = now
+	}
+	var transmit time.Duration
+	if link.Bandwidth > 0 {
+		transmit = time.Duration(int64(len(b)) * int64(time.Second) / link.Bandwidth)
+	}
+	p.sendDone = start.Add(transmit)
+	deliverAt := p.sendDone.Add(link.Latency)
+	if link.Jitter > 0 {
+		deliverAt = deliverAt.Add(time.Duration(p.rand.Int63n(int64(link.Jitter))))
+	}
+	// keep the data in order if the latency decreased
+	if deliverAt.Before(p.lastDeliver) {
+		deliverAt = p.lastDeliver
+	}
+	p.lastDeliver = deliverAt
+
+	data := make([]byte, len(b))
+	copy(data, b)
+	p.chunks = append(p.chunks, simChunk{data: data, deliverAt: deliverAt})
+	sendDone := p.sendDone
+	p.mtx.Unlock()
+
+	p.notify()
+
+	// Block the writer while the link is busy, as a saturated link would.
+	if wait := sendDone.Sub(now); wait > 0 {
+		<-p.network.clock.After(wait)
+	}
+	return len(b), nil
+}
+
+func (p *simPipe) read(b []byte, deadline func() time.Time) (int, error) {
+	for {
+		wait := time.Duration(-1) // wait until notified
+
+		p.mtx.Lock()
+		switch {
+		case p.readerClosed:
+			p.mtx.Unlock()
+			return 0, io.ErrClosedPipe
+
+		case len(p.pending) > 0:
+			n := copy(b, p.pending)
+			p.pending = p.pending[n:]
+			p.mtx.Unlock()
+			return n, nil
+
+		case len(p.chunks) > 0:
+			if !p.network.connected(p.src, p.dst) {
+				break // held back until the partition heals
+			}
+			chunk := p.chunks[0]
+			if d := chunk.deliverAt.Sub(p.network.clock.Now()); d > 0 {
+				wait = d
+				break
+			}
+			p.chunks = p.chunks[1:]
+			n := copy(b, chunk.data)
+			p.pending = chunk.data[n:]
+			p.mtx.Unlock()
+			return n, nil
+
+		case p.writerClosed:
+			p.mtx.Unlock()
+			return 0, io.EOF
+		}
+		p.mtx.Unlock()
+
+		// the delivery follows the network's clock, the deadline the wall clock
+		var deliverc <-chan time.Time
+		if wait >= 0 {
+			deliverc = p.network.clock.After(wait)
+		}
+		var deadlineTimer *time.Timer
+		var deadlinec <-chan time.Time
+		if dl := deadline(); !dl.IsZero() {
+			d := time.Until(dl)
+			if d <= 0 {
+				return 0, simTimeoutError{}
+			}
+			deadlineTimer = time.NewTimer(d)
+			deadlinec = deadlineTimer.C
+		}
+
+		select {
+		case <-p.notifyc:
+		case <-deliverc:
+		case <-deadlinec:
+		}
+		if deadlineTimer != nil {
+			deadlineTimer.Stop()
+		}
+	}
+}
+
+func (p *simPipe) closeReader() {
+	p.mtx.Lock()
+	p.readerClosed = true
+	p.chunks = nil
+	p.pending = nil
+	released := p.writerClosed
+	p.mtx.Unlock()
+	p.notify()
+	if released {
+		p.network.removePipe(p)
+	}
+}
+
+func (p *simPipe) closeWriter() {
+	p.mtx.Lock()
+	p.writerClosed = true
+	released := p.readerClosed
+	p.mtx.Unlock()
+	p.notify()
+	if released {
+		p.network.removePipe(p)
+	}
+}
+
+// simTimeoutError is returned when a SimNetwork operation times out.
+type simTimeoutError struct{}
+
+var _ net.Error = simTimeoutError{}
+
+func (simTimeoutError) Error() string   { return "i/o timeout" }
+func (simTimeoutError) Timeout() bool   { return true }
+func (simTimeoutError) Temporary() bool { return true }
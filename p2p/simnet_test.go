@@ -0,0 +1,226 @@
+package p2p
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	simIP1 = net.IPv4(10, 0, 0, 1)
+	simIP2 = net.IPv4(10, 0, 0, 2)
+)
+
+// simConnPair returns a connection dialed from simIP1 to simIP2 and the
+// accepted end of it.
+func simConnPair(t *testing.T, sn *SimNetwork) (net.Conn, net.Conn) {
+	addr := NetAddress{IP: simIP2, Port: 26656}
+	ln, err := sn.Host(simIP2).Listen(addr)
+	require.NoError(t, err)
+
+	acceptc := make(chan net.Conn, 1)
+	go func() {
+		c, err := ln.Accept()
+		require.NoError(t, err)
+		acceptc <- c
+	}()
+
+	dialed, err := sn.Host(simIP1).Dial(addr, time.Second)
+	require.NoError(t, err)
+	return dialed, <-acceptc
+}
+
+func TestSimNetworkReadWrite(t *testing.T) {
+	sn := NewSimNetwork()
+	c1, c2 := simConnPair(t, sn)
+
+	assert.Equal(t, simIP1.String(), c2.RemoteAddr().(*net.TCPAddr).IP.String())
+	assert.Equal(t, simIP2.String(), c1.RemoteAddr().(*net.TCPAddr).IP.String())
+
+	_, err := c1.Write([]byte("hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 3)
+	n, err := c2.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hel", string(buf[:n]))
+	n, err = c2.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "lo", string(buf[:n]))
+
+	// the remote end reads EOF once the connection is closed
+	require.NoError(t, c1.Close())
+	_, err = c2.Read(buf)
+	assert.Equal(t, io.EOF, err)
+	_, err = c2.Write([]byte("hello"))
+	assert.Error(t, err)
+}
+
+func TestSimNetworkCloseReleasesPipes(t *testing.T) {
+	sn := NewSimNetwork()
+	c1, c2 := simConnPair(t, sn)
+	require.NoError(t, c1.Close())
+	require.NoError(t, c2.Close())
+	assert.Empty(t, sn.pipes)
+
+	// closing the network closes its remaining connections
+	sn = NewSimNetwork()
+	_, c2 = simConnPair(t, sn)
+	sn.Close()
+	assert.Empty(t, sn.pipes)
+	_, err := c2.Read(make([]byte, 1))
+	assert.Error(t, err)
+	_, err = sn.Host(simIP1).Dial(NetAddress{IP: simIP2, Port: 26656}, time.Second)
+	assert.Error(t, err)
+}
+
+func TestSimNetworkDialRefused(t *testing.T) {
+	sn := NewSimNetwork()
+	_, err := sn.Host(simIP1).Dial(NetAddress{IP: simIP2, Port: 26656}, time.Second)
+	assert.Error(t, err)
+}
+
+func TestSimNetworkReadDeadline(t *testing.T) {
+	sn := NewSimNetwork()
+	_, c2 := simConnPair(t, sn)
+
+	require.NoError(t, c2.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+	_, err := c2.Read(make([]byte, 1))
+	require.Error(t, err)
+	netErr, ok := err.(net.Error)
+	require.True(t, ok)
+	assert.True(t, netErr.Timeout())
+}
+
+func TestSimNetworkLatency(t *testing.T) {
+	sn := NewSimNetwork()
+	sn.SetLink(simIP1, simIP2, SimLink{Latency: 100 * time.Millisecond})
+	c1, c2 := simConnPair(t, sn)
+
+	start := time.Now()
+	_, err := c1.Write([]byte("hello"))
+	require.NoError(t, err)
+	_, err = c2.Read(make([]byte, 5))
+	require.NoError(t, err)
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+}
+
+func TestSimNetworkManualClock(t *testing.T) {
+	clock := NewSimManualClock(time.Unix(0, 0))
+	sn := NewSimNetwork(SimNetworkClock(clock))
+	sn.SetLink(simIP1, simIP2, SimLink{Latency: time.Second})
+	c1, c2 := simConnPair(t, sn)
+
+	_, err := c1.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	// nothing is delivered until the clock has moved past the latency
+	clock.Advance(999 * time.Millisecond)
+	require.NoError(t, c2.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+	_, err = c2.Read(make([]byte, 5))
+	require.Error(t, err)
+
+	clock.Advance(time.Millisecond)
+	require.NoError(t, c2.SetReadDeadline(time.Time{}))
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(c2, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+}
+
+func TestSimNetworkJitterIsSeeded(t *testing.T) {
+	deliveries := func(seed int64) []time.Time {
+		clock := NewSimManualClock(time.Unix(0, 0))
+		sn := NewSimNetwork(SimNetworkClock(clock), SimNetworkSeed(seed))
+		sn.SetDefaultLink(SimLink{Latency: time.Second, Jitter: time.Second})
+		c1, _ := simConnPair(t, sn)
+		for i := 0; i < 10; i++ {
+			_, err := c1.Write([]byte{byte(i)})
+			require.NoError(t, err)
+		}
+
+		out := c1.(*simConn).out
+		out.mtx.Lock()
+		defer out.mtx.Unlock()
+		times := make([]time.Time, len(out.chunks))
+		for i, chunk := range out.chunks {
+			times[i] = chunk.deliverAt
+		}
+		return times
+	}
+
+	assert.Equal(t, deliveries(1), deliveries(1))
+	assert.NotEqual(t, deliveries(1), deliveries(2))
+}
+
+func TestSimNetworkBandwidth(t *testing.T) {
+	sn := NewSimNetwork()
+	sn.SetDefaultLink(SimLink{Bandwidth: 10000})
+	c1, c2 := simConnPair(t, sn)
+
+	go func() {
+		_, _ = io.ReadFull(c2, make([]byte, 2000))
+	}()
+
+	// 2000 bytes at 10000 bytes/s take 200ms to send
+	start := time.Now()
+	_, err := c1.Write(make([]byte, 2000))
+	require.NoError(t, err)
+	assert.True(t, time.Since(start) >= 200*time.Millisecond)
+}
+
+func TestSimNetworkPartition(t *testing.T) {
+	sn := NewSimNetwork()
+	c1, c2 := simConnPair(t, sn)
+
+	sn.Partition([]net.IP{simIP1}, []net.IP{simIP2})
+
+	// dials across the partition time out
+	_, err := sn.Host(simIP1).Dial(NetAddress{IP: simIP2, Port: 26656}, 50*time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, err.(net.Error).Timeout())
+
+	// data is held back until the partition heals
+	_, err = c1.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, c2.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+	_, err = c2.Read(make([]byte, 5))
+	require.Error(t, err)
+
+	sn.Heal()
+	require.NoError(t, c2.SetReadDeadline(time.Time{}))
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(c2, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+}
+
+func TestSimNetworkSwitches(t *testing.T) {
+	sn := NewSimNetwork()
+	defer sn.Close()
+	sn.SetDefaultLink(SimLink{Latency: 10 * time.Millisecond})
+	switches := MakeSimSwitches(cfg, sn, 3, initSwitchFunc, ConnectSimSwitches)
+	for _, sw := range switches {
+		defer sw.Stop()
+		assert.Equal(t, 2, sw.Peers().Size())
+	}
+
+	// isolate the last switch, messages to it are delayed until the
+	// partition heals
+	sn.Partition(SwitchIPs(switches[0], switches[1]), SwitchIPs(switches[2]))
+
+	msg := []byte("channel zero")
+	switches[0].Broadcast(byte(0x00), msg)
+	assertMsgReceivedWithTimeout(t, msg, byte(0x00), switches[1].Reactor("foo").(*TestReactor),
+		10*time.Millisecond, 5*time.Second)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, switches[2].Reactor("foo").(*TestReactor).getMsgs(byte(0x00)))
+
+	sn.Heal()
+	assertMsgReceivedWithTimeout(t, msg, byte(0x00), switches[2].Reactor("foo").(*TestReactor),
+		10*time.Millisecond, 5*time.Second)
+}
@@ -49,6 +49,7 @@ type AddrBook interface {
 	MarkGood(*NetAddress)
 	RemoveAddress(*NetAddress)
 	HasAddress(*NetAddress) bool
+	IsBanned(ID) bool
 	Save()
 }
 
@@ -73,6 +74,7 @@ type Switch struct {
 	dialing      *cmn.CMap
 	reconnecting *cmn.CMap
 	nodeInfo     NodeInfo // our node info
+	nodeInfoMtx  sync.RWMutex
 	nodeKey      *NodeKey // our node privkey
 	addrBook     AddrBook
 
@@ -81,6 +83,9 @@ type Switch struct {
 	filterTimeout time.Duration
 	peerFilters   []PeerFilterFunc
 
+	persistentPeers      *cmn.CMap // peers to reconnect to, by ID
+	unconditionalPeerIDs *cmn.CMap // peers exempt from the peer limits
+
 	rng *cmn.Rand // seed for randomizing dial times and orders
 
 	metrics *Metrics
@@ -106,6 +111,9 @@ func NewSwitch(
 		metrics:       NopMetrics(),
 		transport:     transport,
 		filterTimeout: defaultFilterTimeout,
+
+		persistentPeers:      cmn.NewCMap(),
+		unconditionalPeerIDs: cmn.NewCMap(),
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -170,17 +178,41 @@ func (sw *Switch) Reactor(name string) Reactor {
 }
 
 // SetNodeInfo sets the switch's NodeInfo for checking compatibility and handshaking with other nodes.
-// NOTE: Not goroutine safe.
 func (sw *Switch) SetNodeInfo(nodeInfo NodeInfo) {
+	sw.nodeInfoMtx.Lock()
+	defer sw.nodeInfoMtx.Unlock()
 	sw.nodeInfo = nodeInfo
 }
 
 // NodeInfo returns the switch's NodeInfo.
-// NOTE: Not goroutine safe.
 func (sw *Switch) NodeInfo() NodeInfo {
+	sw.nodeInfoMtx.RLock()
+	defer sw.nodeInfoMtx.RUnlock()
 	return sw.nodeInfo
 }
 
+// SetExternalAddress updates the address our node advertises to peers, e.g.
+// after discovering it from the addresses peers see us on. It only applies
+// to DefaultNodeInfo.
+func (sw *Switch) SetExternalAddress(addr *NetAddress) error {
+	nodeInfo, ok := sw.NodeInfo().(DefaultNodeInfo)
+	if !ok {
+		return fmt.Errorf("can't set the external address of %T", sw.NodeInfo())
+	}
+	nodeInfo.ListenAddr = addr.ListenString()
+	if err := nodeInfo.Validate(); err != nil {
+		return err
+	}
+	sw.SetNodeInfo(nodeInfo)
+	if t, ok := sw.transport.(nodeInfoSetter); ok {
+		t.SetNodeInfo(nodeInfo)
+	}
+	if sw.addrBook != nil {
+		sw.addrBook.AddOurAddress(addr)
+	}
+	return nil
+}
+
 // SetNodeKey sets the switch's private key for authenticated encryption.
 // NOTE: Not goroutine safe.
 func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
@@ -253,9 +285,13 @@ func (sw *Switch) Broadcast(chID byte, msgBytes []byte) chan bool {
 }
 
 // NumPeers returns the count of outbound/inbound and outbound-dialing peers.
+// Unconditional peers are not counted, as they don't take up peer slots.
 func (sw *Switch) NumPeers() (outbound, inbound, dialing int) {
 	peers := sw.peers.List()
 	for _, peer := range peers {
+		if sw.IsPeerUnconditional(peer.ID()) {
+			continue
+		}
 		if peer.IsOutbound() {
 			outbound++
 		} else {
@@ -283,15 +319,92 @@ func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	sw.Logger.Error("Stopping peer for error", "peer", peer, "err", reason)
 	sw.stopAndRemovePeer(peer, reason)
 
-	if peer.IsPersistent() {
-		addr := peer.OriginalAddr()
-		if addr == nil {
-			// FIXME: persistent peers can't be inbound right now.
-			// self-reported address for inbound persistent peers
-			addr = peer.NodeInfo().NetAddress()
-		}
-		go sw.reconnectToPeer(addr)
+	if addr := sw.persistentPeers.Get(string(peer.ID())); addr != nil {
+		go sw.reconnectToPeer(addr.(*NetAddress))
+	}
+}
+
+// AddPersistentPeer makes the peer with the given address persistent: the
+// switch reconnects to it whenever the connection fails. It doesn't dial the
+// peer.
+func (sw *Switch) AddPersistentPeer(addr *NetAddress) {
+	sw.Logger.Info("Add persistent peer", "addr", addr)
+	sw.persistentPeers.Set(string(addr.ID), addr)
+}
+
+// RemovePersistentPeer stops reconnecting to the peer with the given ID,
+// without disconnecting it. Returns false if the peer wasn't persistent.
+func (sw *Switch) RemovePersistentPeer(id ID) bool {
+	if !sw.persistentPeers.Has(string(id)) {
+		return false
+	}
+	sw.Logger.Info("Remove persistent peer", "id", id)
+	sw.persistentPeers.Delete(string(id))
+	return true
+}
+
+// IsPeerPersistent returns true if the peer with the given ID is persistent.
+func (sw *Switch) IsPeerPersistent(id ID) bool {
+	return sw.persistentPeers.Has(string(id))
+}
+
+// PersistentPeers returns the addresses of the persistent peers.
+func (sw *Switch) PersistentPeers() []*NetAddress {
+	values := sw.persistentPeers.Values()
+	addrs := make([]*NetAddress, 0, len(values))
+	for _, addr := range values {
+		addrs = append(addrs, addr.(*NetAddress))
 	}
+	return addrs
+}
+
+// AddUnconditionalPeer exempts the peer with the given ID from the limits on
+// the number of peers.
+func (sw *Switch) AddUnconditionalPeer(id ID) {
+	sw.Logger.Info("Add unconditional peer", "id", id)
+	sw.unconditionalPeerIDs.Set(string(id), struct{}{})
+}
+
+// AddUnconditionalPeerIDs adds the peers with the given hex-encoded IDs to
+// the unconditional peers. Returns an error if any ID is invalid, in which
+// case none is added.
+func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
+	peerIDs, err := ParseIDs(ids)
+	if err != nil {
+		return err
+	}
+	for _, id := range peerIDs {
+		sw.AddUnconditionalPeer(id)
+	}
+	return nil
+}
+
+// RemoveUnconditionalPeer subjects the peer with the given ID to the limits
+// on the number of peers again. Returns false if the peer wasn't
+// unconditional.
+func (sw *Switch) RemoveUnconditionalPeer(id ID) bool {
+	if !sw.unconditionalPeerIDs.Has(string(id)) {
+		return false
+	}
+	sw.Logger.Info("Remove unconditional peer", "id", id)
+	sw.unconditionalPeerIDs.Delete(string(id))
+	return true
+}
+
+// IsPeerUnconditional returns true if the peer with the given ID is exempt
+// from the limits on the number of peers.
+func (sw *Switch) IsPeerUnconditional(id ID) bool {
+	return sw.unconditionalPeerIDs.Has(string(id))
+}
+
+// UnconditionalPeerIDs returns the IDs of the unconditional peers.
+func (sw *Switch) UnconditionalPeerIDs() []ID {
+	keys := sw.unconditionalPeerIDs.Keys()
+	ids := make([]ID, 0, len(keys))
+	for _, id := range keys {
+		ids = append(ids, ID(id))
+	}
+	return ids
 }
 
 // StopPeerGracefully disconnects from a peer gracefully.
@@ -330,7 +443,7 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	start := time.Now()
 	sw.Logger.Info("Reconnecting to peer", "addr", addr)
 	for i := 0; i < reconnectAttempts; i++ {
-		if !sw.IsRunning() {
+		if !sw.IsRunning() || !sw.IsPeerPersistent(addr.ID) {
 			return
 		}
 
@@ -339,6 +452,7 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return
 		}
 
+		addr = sw.resolvePersistentPeer(addr)
 		err := sw.DialPeerWithAddress(addr, true)
 		if err == nil {
 			return // success
@@ -353,13 +467,14 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	sw.Logger.Error("Failed to reconnect to peer. Beginning exponential backoff",
 		"addr", addr, "elapsed", time.Since(start))
 	for i := 0; i < reconnectBackOffAttempts; i++ {
-		if !sw.IsRunning() {
+		if !sw.IsRunning() || !sw.IsPeerPersistent(addr.ID) {
 			return
 		}
 
 		// sleep an exponentially increasing amount
 		sleepIntervalSeconds := math.Pow(reconnectBackOffBaseSeconds, float64(i))
 		sw.randomSleep(time.Duration(sleepIntervalSeconds) * time.Second)
+		addr = sw.resolvePersistentPeer(addr)
 		err := sw.DialPeerWithAddress(addr, true)
 		if err == nil {
 			return // success
@@ -369,6 +484,25 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 	sw.Logger.Error("Failed to reconnect to peer. Giving up", "addr", addr, "elapsed", time.Since(start))
 }
 
+// resolvePersistentPeer looks up the hostname of a persistent peer's address
+// again, if it was given as one, so peers behind changing DNS records can be
+// reconnected to. The previous address is returned if the lookup fails.
+func (sw *Switch) resolvePersistentPeer(addr *NetAddress) *NetAddress {
+	resolved, err := addr.Resolve()
+	if err != nil {
+		sw.Logger.Info("Failed to resolve peer address", "addr", addr, "err", err)
+		return addr
+	}
+	if resolved != addr {
+		sw.Logger.Info("Peer address resolved to a new IP",
+			"host", addr.Hostname(), "old", addr, "new", resolved)
+		if sw.IsPeerPersistent(addr.ID) {
+			sw.persistentPeers.Set(string(addr.ID), resolved)
+		}
+	}
+	return resolved
+}
+
 // SetAddrBook allows to set address book on Switch.
 func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 	sw.addrBook = addrBook
@@ -395,7 +529,7 @@ func (sw *Switch) DialPeersAsync(addrBook AddrBook, peers []string, persistent b
 		sw.Logger.Error("Error in peer's address", "err", err)
 	}
 
-	ourAddr := sw.nodeInfo.NetAddress()
+	ourAddr := sw.NodeInfo().NetAddress()
 
 	// TODO: this code feels like it's in the wrong place.
 	// The integration tests depend on the addrBook being saved
@@ -521,9 +655,22 @@ func (sw *Switch) acceptRoutine() {
 			break
 		}
 
+		// Ignore connection if the peer is banned. The ban is checked by ID,
+		// since the peer's listen address may not even parse.
+		if sw.addrBook != nil && sw.addrBook.IsBanned(p.ID()) {
+			sw.Logger.Info(
+				"Ignoring inbound connection: peer is banned",
+				"id", p.ID(),
+			)
+
+			sw.transport.Cleanup(p)
+
+			continue
+		}
+
 		// Ignore connection if we already have enough peers.
 		_, in, _ := sw.NumPeers()
-		if in >= sw.config.MaxNumInboundPeers {
+		if in >= sw.config.MaxNumInboundPeers && !sw.IsPeerUnconditional(p.ID()) {
 			sw.Logger.Info(
 				"Ignoring inbound connection: already have enough inbound peers",
 				"address", p.NodeInfo().NetAddress().String(),
@@ -562,6 +709,10 @@ func (sw *Switch) addOutboundPeerWithConfig(
 ) error {
 	sw.Logger.Info("Dialing peer", "address", addr)
 
+	if persistent {
+		sw.AddPersistentPeer(addr)
+	}
+
 	// XXX(xla): Remove the leakage of test concerns in implementation.
 	if cfg.TestDialFail {
 		go sw.reconnectToPeer(addr)
@@ -643,6 +794,11 @@ func (sw *Switch) addPeer(p Peer) error {
 		return err
 	}
 
+	// Reconnect to peers dialed as persistent if they fail.
+	if p.IsPersistent() && p.OriginalAddr() != nil && !sw.IsPeerPersistent(p.ID()) {
+		sw.AddPersistentPeer(p.OriginalAddr())
+	}
+
 	p.SetLogger(sw.Logger.With("peer", p.NodeInfo().NetAddress()))
 
 	// Handle the shut down case where the switch has stopped but we're
@@ -34,6 +34,23 @@ func init() {
 	cfg.AllowDuplicateIP = true
 }
 
+// testProtocols are the protocols the tests of switches and peers talking over
+// a transport are run with, see runWithProtocols. QUIC is added in builds with
+// the quic tag.
+var testProtocols = []string{ProtocolTCP, ProtocolWebSocket}
+
+// runWithProtocols runs f as a subtest for each of testProtocols, with the
+// test switches and remote peers listening on addresses of that protocol.
+func runWithProtocols(t *testing.T, f func(t *testing.T)) {
+	for _, protocol := range testProtocols {
+		t.Run(protocol, func(t *testing.T) {
+			defer func(protocol string) { testProtocol = protocol }(testProtocol)
+			testProtocol = protocol
+			f(t)
+		})
+	}
+}
+
 type PeerMessage struct {
 	PeerID  ID
 	Bytes   []byte
@@ -159,6 +176,10 @@ func assertMsgReceivedWithTimeout(t *testing.T, msgBytes []byte, channel byte, r
 }
 
 func TestSwitchFiltersOutItself(t *testing.T) {
+	runWithProtocols(t, testSwitchFiltersOutItself)
+}
+
+func testSwitchFiltersOutItself(t *testing.T) {
 	s1 := MakeSwitch(cfg, 1, "127.0.0.1", "123.123.123", initSwitchFunc)
 	// addr := s1.NodeInfo().NetAddress()
 
@@ -190,6 +211,10 @@ func TestSwitchFiltersOutItself(t *testing.T) {
 }
 
 func TestSwitchPeerFilter(t *testing.T) {
+	runWithProtocols(t, testSwitchPeerFilter)
+}
+
+func testSwitchPeerFilter(t *testing.T) {
 	var (
 		filters = []PeerFilterFunc{
 			func(_ IPeerSet, _ Peer) error { return nil },
@@ -232,6 +257,10 @@ func TestSwitchPeerFilter(t *testing.T) {
 }
 
 func TestSwitchPeerFilterTimeout(t *testing.T) {
+	runWithProtocols(t, testSwitchPeerFilterTimeout)
+}
+
+func testSwitchPeerFilterTimeout(t *testing.T) {
 	var (
 		filters = []PeerFilterFunc{
 			func(_ IPeerSet, _ Peer) error {
@@ -272,6 +301,10 @@ func TestSwitchPeerFilterTimeout(t *testing.T) {
 }
 
 func TestSwitchPeerFilterDuplicate(t *testing.T) {
+	runWithProtocols(t, testSwitchPeerFilterDuplicate)
+}
+
+func testSwitchPeerFilterDuplicate(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
 
 	// simulate remote peer
@@ -310,6 +343,10 @@ func assertNoPeersAfterTimeout(t *testing.T, sw *Switch, timeout time.Duration)
 }
 
 func TestSwitchStopsNonPersistentPeerOnError(t *testing.T) {
+	runWithProtocols(t, testSwitchStopsNonPersistentPeerOnError)
+}
+
+func testSwitchStopsNonPersistentPeerOnError(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
@@ -337,7 +374,7 @@ func TestSwitchStopsNonPersistentPeerOnError(t *testing.T) {
 	require.NotNil(sw.Peers().Get(rp.ID()))
 
 	// simulate failure by closing connection
-	p.(*peer).CloseConn()
+	p.CloseConn() // nolint: errcheck
 
 	assertNoPeersAfterTimeout(t, sw, 100*time.Millisecond)
 	assert.False(p.IsRunning())
@@ -392,6 +429,10 @@ func TestSwitchStopPeerForError(t *testing.T) {
 }
 
 func TestSwitchReconnectsToPersistentPeer(t *testing.T) {
+	runWithProtocols(t, testSwitchReconnectsToPersistentPeer)
+}
+
+func testSwitchReconnectsToPersistentPeer(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
@@ -419,7 +460,7 @@ func TestSwitchReconnectsToPersistentPeer(t *testing.T) {
 	require.NotNil(sw.Peers().Get(rp.ID()))
 
 	// simulate failure by closing connection
-	p.(*peer).CloseConn()
+	p.CloseConn() // nolint: errcheck
 
 	// TODO: remove sleep, detect the disconnection, wait for reconnect
 	npeers := sw.Peers().Size()
@@ -479,6 +520,10 @@ func TestSwitchFullConnectivity(t *testing.T) {
 }
 
 func TestSwitchAcceptRoutine(t *testing.T) {
+	runWithProtocols(t, testSwitchAcceptRoutine)
+}
+
+func testSwitchAcceptRoutine(t *testing.T) {
 	cfg.MaxNumInboundPeers = 5
 
 	// make switch
@@ -530,6 +575,127 @@ func TestSwitchAcceptRoutine(t *testing.T) {
 	}
 }
 
+func TestSwitchRemovedPersistentPeerIsNotReconnected(t *testing.T) {
+	runWithProtocols(t, testSwitchRemovedPersistentPeerIsNotReconnected)
+}
+
+func testSwitchRemovedPersistentPeerIsNotReconnected(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.DialPeerWithAddress(rp.Addr(), true)
+	require.NoError(t, err)
+	p := sw.Peers().Get(rp.ID())
+	require.NotNil(t, p)
+	assert.True(t, sw.IsPeerPersistent(rp.ID()))
+	assert.Equal(t, []*NetAddress{rp.Addr()}, sw.PersistentPeers())
+
+	assert.True(t, sw.RemovePersistentPeer(rp.ID()))
+	assert.False(t, sw.RemovePersistentPeer(rp.ID()))
+	assert.False(t, sw.IsPeerPersistent(rp.ID()))
+	assert.Empty(t, sw.PersistentPeers())
+
+	// simulate failure by closing connection
+	p.CloseConn() // nolint: errcheck
+
+	assertNoPeersAfterTimeout(t, sw, 500*time.Millisecond)
+}
+
+func TestSwitchAcceptsUnconditionalInboundPeer(t *testing.T) {
+	runWithProtocols(t, testSwitchAcceptsUnconditionalInboundPeer)
+}
+
+func testSwitchAcceptsUnconditionalInboundPeer(t *testing.T) {
+	c := *cfg
+	c.MaxNumInboundPeers = 0
+
+	sw := MakeSwitch(&c, 1, "testing", "123.123.123", initSwitchFunc)
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &c}
+	rp.Start()
+	defer rp.Stop()
+
+	sw.AddUnconditionalPeer(rp.ID())
+	assert.True(t, sw.IsPeerUnconditional(rp.ID()))
+	assert.Equal(t, []ID{rp.ID()}, sw.UnconditionalPeerIDs())
+
+	conn, err := rp.Dial(sw.NodeInfo().NetAddress())
+	require.NoError(t, err)
+	// spawn a reading routine to prevent connection from closing
+	go func(c net.Conn) {
+		for {
+			one := make([]byte, 1)
+			_, err := c.Read(one)
+			if err != nil {
+				return
+			}
+		}
+	}(conn)
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, sw.Peers().Size())
+	// unconditional peers don't take up peer slots
+	_, in, _ := sw.NumPeers()
+	assert.Zero(t, in)
+
+	assert.True(t, sw.RemoveUnconditionalPeer(rp.ID()))
+	assert.False(t, sw.RemoveUnconditionalPeer(rp.ID()))
+	assert.Empty(t, sw.UnconditionalPeerIDs())
+}
+
+func TestSwitchAddUnconditionalPeerIDs(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+
+	id := string(PubKeyToID(ed25519.GenPrivKey().PubKey()))
+	err := sw.AddUnconditionalPeerIDs([]string{id, "invalid"})
+	assert.Error(t, err)
+	assert.Empty(t, sw.UnconditionalPeerIDs())
+
+	err = sw.AddUnconditionalPeerIDs([]string{id})
+	require.NoError(t, err)
+	assert.True(t, sw.IsPeerUnconditional(ID(id)))
+}
+
+func TestSwitchRejectsBannedInboundPeer(t *testing.T) {
+	runWithProtocols(t, testSwitchRejectsBannedInboundPeer)
+}
+
+func testSwitchRejectsBannedInboundPeer(t *testing.T) {
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", func(i int, sw *Switch) *Switch {
+		sw.SetAddrBook(&addrBookMock{
+			addrs:    make(map[string]struct{}),
+			ourAddrs: make(map[string]struct{}),
+			banned:   map[ID]struct{}{rp.ID(): {}},
+		})
+		return sw
+	})
+	err := sw.Start()
+	require.NoError(t, err)
+	defer sw.Stop()
+
+	conn, err := rp.Dial(sw.NodeInfo().NetAddress())
+	require.NoError(t, err)
+	// check conn is closed
+	one := make([]byte, 1)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+	_, err = conn.Read(one)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 0, sw.Peers().Size())
+}
+
 func BenchmarkSwitchBroadcast(b *testing.B) {
 	s1, s2 := MakeSwitchPair(b, func(i int, sw *Switch) *Switch {
 		// Make bar reactors of bar channels each
@@ -572,6 +738,7 @@ func BenchmarkSwitchBroadcast(b *testing.B) {
 type addrBookMock struct {
 	addrs    map[string]struct{}
 	ourAddrs map[string]struct{}
+	banned   map[ID]struct{}
 }
 
 var _ AddrBook = (*addrBookMock)(nil)
@@ -593,4 +760,8 @@ func (book *addrBookMock) HasAddress(addr *NetAddress) bool {
 func (book *addrBookMock) RemoveAddress(addr *NetAddress) {
 	delete(book.addrs, addr.String())
 }
+func (book *addrBookMock) IsBanned(id ID) bool {
+	_, ok := book.banned[id]
+	return ok
+}
 func (book *addrBookMock) Save() {}
@@ -175,7 +175,36 @@ func MakeSwitch(
 	}
 	nodeInfo := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i))
 
-	t := NewMultiplexTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+	return makeSwitch(cfg, i, nodeKey, nodeInfo, initSwitch, nil, opts...)
+}
+
+func makeSwitch(
+	cfg *config.P2PConfig,
+	i int,
+	nodeKey NodeKey,
+	nodeInfo NodeInfo,
+	initSwitch func(int, *Switch) *Switch,
+	transportOpts []MultiplexTransportOption,
+	opts ...SwitchOption,
+) *Switch {
+	var t interface {
+		Transport
+		transportLifecycle
+		nodeInfoSetter
+	}
+	if nodeInfo.NetAddress().TransportProtocol() == ProtocolQUIC {
+		qt, err := NewQUICTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+		if err != nil {
+			panic(err)
+		}
+		t = qt
+	} else {
+		mt := NewMultiplexTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+		for _, opt := range transportOpts {
+			opt(mt)
+		}
+		t = mt
+	}
 
 	addr := nodeInfo.NetAddress()
 	if err := t.Listen(*addr); err != nil {
@@ -195,12 +224,85 @@ func MakeSwitch(
 
 	// TODO: We need to setup reactors ahead of time so the NodeInfo is properly
 	// populated and we don't have to do those awkward overrides and setters.
-	t.nodeInfo = nodeInfo
+	t.SetNodeInfo(nodeInfo)
 	sw.SetNodeInfo(nodeInfo)
 
 	return sw
 }
 
+//------------------------------------------------------------------
+// Connects switches over a SimNetwork. Used for testing.
+
+// MakeSimSwitches returns n started switches whose transports listen on and
+// dial over the given SimNetwork, connected according to the connect func.
+// The i'th switch uses the IP 10.0.0.(i+1), see SwitchIPs.
+// NOTE: panics if any switch fails to start.
+func MakeSimSwitches(
+	cfg *config.P2PConfig,
+	network *SimNetwork,
+	n int,
+	initSwitch func(int, *Switch) *Switch,
+	connect func([]*Switch, int, int),
+) []*Switch {
+	switches := make([]*Switch, n)
+	for i := 0; i < n; i++ {
+		nodeKey := NodeKey{
+			PrivKey: ed25519.GenPrivKey(),
+		}
+		ip := net.IPv4(10, 0, 0, byte(i+1))
+		ni := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i)).(DefaultNodeInfo)
+		ni.ListenAddr = fmt.Sprintf("%v:26656", ip)
+		// the channels are those of the reactors added by initSwitch
+		ni.Channels = nil
+
+		switches[i] = makeSwitch(cfg, i, nodeKey, ni, initSwitch,
+			[]MultiplexTransportOption{MultiplexTransportNetwork(network.Host(ip))})
+	}
+
+	if err := StartSwitches(switches); err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			connect(switches, i, j)
+		}
+	}
+
+	return switches
+}
+
+// ConnectSimSwitches makes switch i dial switch j, as created by
+// MakeSimSwitches. Blocks until both switches have added each other as peers.
+// NOTE: panics if the peers aren't added within a few seconds.
+func ConnectSimSwitches(switches []*Switch, i, j int) {
+	switchI := switches[i]
+	switchJ := switches[j]
+
+	if err := switchI.DialPeerWithAddress(switchJ.NodeInfo().NetAddress(), false); err != nil {
+		panic(err)
+	}
+
+	timeout := time.After(5 * time.Second)
+	for !switchI.Peers().Has(switchJ.NodeInfo().ID()) || !switchJ.Peers().Has(switchI.NodeInfo().ID()) {
+		select {
+		case <-timeout:
+			panic(fmt.Sprintf("switches %d and %d failed to connect", i, j))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// SwitchIPs returns the IPs of the given switches, eg. to partition a
+// SimNetwork.
+func SwitchIPs(switches ...*Switch) []net.IP {
+	ips := make([]net.IP, len(switches))
+	for i, sw := range switches {
+		ips[i] = sw.NodeInfo().NetAddress().IP
+	}
+	return ips
+}
+
 func testInboundPeerConn(
 	conn net.Conn,
 	config *config.P2PConfig,
@@ -242,6 +344,10 @@ func testPeerConn(
 //----------------------------------------------------------------
 // rand node info
 
+// testProtocol is the protocol the test switches and remote peers of this
+// package listen on, see runWithProtocols.
+var testProtocol = ""
+
 func testNodeInfo(id ID, name string) NodeInfo {
 	return testNodeInfoWithNetwork(id, name, "testing")
 }
@@ -254,7 +360,7 @@ func testNodeInfoWithNetwork(id ID, name, network string) NodeInfo {
 	return DefaultNodeInfo{
 		ProtocolVersion: defaultProtocolVersion,
 		ID_:             id,
-		ListenAddr:      fmt.Sprintf("127.0.0.1:%d", port),
+		ListenAddr:      withProtocol(testProtocol, fmt.Sprintf("127.0.0.1:%d", port)),
 		Network:         network,
 		Version:         "1.2.3-rc0-deadbeef",
 		Channels:        []byte{testCh},
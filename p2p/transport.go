@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -21,6 +22,24 @@ type IPResolver interface {
 	LookupIPAddr(context.Context, string) ([]net.IPAddr, error)
 }
 
+// TransportNetwork is the network over which a MultiplexTransport listens for
+// and dials connections.
+type TransportNetwork interface {
+	Listen(addr NetAddress) (net.Listener, error)
+	Dial(addr NetAddress, timeout time.Duration) (net.Conn, error)
+}
+
+// tcpNetwork is the default TransportNetwork, using TCP.
+type tcpNetwork struct{}
+
+func (tcpNetwork) Listen(addr NetAddress) (net.Listener, error) {
+	return net.Listen("tcp", addr.DialString())
+}
+
+func (tcpNetwork) Dial(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	return addr.DialTimeout(timeout)
+}
+
 // accept is the container to carry the upgraded connection and NodeInfo from an
 // asynchronously running routine to the Accept method.
 type accept struct {
@@ -64,6 +83,12 @@ type transportLifecycle interface {
 	Listen(NetAddress) error
 }
 
+// nodeInfoSetter is implemented by transports whose NodeInfo, sent to peers
+// during the handshake, can be updated at runtime.
+type nodeInfoSetter interface {
+	SetNodeInfo(NodeInfo)
+}
+
 // ConnFilterFunc to be implemented by filter hooks after a new connection has
 // been established. The set of exisiting connections is passed along together
 // with all resolved IPs for the new connection.
@@ -112,6 +137,18 @@ func MultiplexTransportResolver(resolver IPResolver) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.resolver = resolver }
 }
 
+// MultiplexTransportNetwork sets the network connections are listened for and
+// dialed on, whatever the protocol of the address. By default addresses are
+// listened on and dialed over TCP or WebSocket according to their protocol.
+// Tests use it to run switches over a SimNetwork.
+func MultiplexTransportNetwork(network TransportNetwork) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) {
+		for protocol := range mt.networks {
+			mt.networks[protocol] = network
+		}
+	}
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -128,8 +165,10 @@ type MultiplexTransport struct {
 	filterTimeout    time.Duration
 	handshakeTimeout time.Duration
 	nodeInfo         NodeInfo
+	nodeInfoMtx      sync.RWMutex
 	nodeKey          NodeKey
 	resolver         IPResolver
+	networks         map[string]TransportNetwork // by protocol
 
 	// TODO(xla): This config is still needed as we parameterise peerConn and
 	// peer currently. All relevant configuration should be refactored into options
@@ -140,6 +179,7 @@ type MultiplexTransport struct {
 // Test multiplexTransport for interface completeness.
 var _ Transport = (*MultiplexTransport)(nil)
 var _ transportLifecycle = (*MultiplexTransport)(nil)
+var _ nodeInfoSetter = (*MultiplexTransport)(nil)
 
 // NewMultiplexTransport returns a tcp connected multiplexed peer.
 func NewMultiplexTransport(
@@ -158,9 +198,26 @@ func NewMultiplexTransport(
 		nodeKey:          nodeKey,
 		conns:            NewConnSet(),
 		resolver:         net.DefaultResolver,
+		networks: map[string]TransportNetwork{
+			ProtocolTCP:       tcpNetwork{},
+			ProtocolWebSocket: wsNetwork{},
+		},
 	}
 }
 
+// SetNodeInfo updates the NodeInfo sent to peers during the handshake.
+func (mt *MultiplexTransport) SetNodeInfo(nodeInfo NodeInfo) {
+	mt.nodeInfoMtx.Lock()
+	defer mt.nodeInfoMtx.Unlock()
+	mt.nodeInfo = nodeInfo
+}
+
+func (mt *MultiplexTransport) getNodeInfo() NodeInfo {
+	mt.nodeInfoMtx.RLock()
+	defer mt.nodeInfoMtx.RUnlock()
+	return mt.nodeInfo
+}
+
 // Accept implements Transport.
 func (mt *MultiplexTransport) Accept(cfg peerConfig) (Peer, error) {
 	select {
@@ -184,7 +241,12 @@ func (mt *MultiplexTransport) Dial(
 	addr NetAddress,
 	cfg peerConfig,
 ) (Peer, error) {
-	c, err := addr.DialTimeout(mt.dialTimeout)
+	network, err := mt.network(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := network.Dial(addr, mt.dialTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +281,12 @@ func (mt *MultiplexTransport) Close() error {
 
 // Listen implements transportLifecycle.
 func (mt *MultiplexTransport) Listen(addr NetAddress) error {
-	ln, err := net.Listen("tcp", addr.DialString())
+	network, err := mt.network(addr)
+	if err != nil {
+		return err
+	}
+
+	ln, err := network.Listen(addr)
 	if err != nil {
 		return err
 	}
@@ -231,6 +298,16 @@ func (mt *MultiplexTransport) Listen(addr NetAddress) error {
 	return nil
 }
 
+// network returns the network addr is listened on and dialed over, according
+// to its protocol.
+func (mt *MultiplexTransport) network(addr NetAddress) (TransportNetwork, error) {
+	network, ok := mt.networks[addr.TransportProtocol()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported protocol %q", addr.Protocol)
+	}
+	return network, nil
+}
+
 func (mt *MultiplexTransport) acceptPeers() {
 	for {
 		c, err := mt.listener.Accept()
@@ -290,7 +367,19 @@ func (mt *MultiplexTransport) cleanup(c net.Conn) error {
 	return c.Close()
 }
 
-func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
+func (mt *MultiplexTransport) filterConn(c net.Conn) error {
+	return filterConn(mt.conns, mt.connFilters, mt.resolver, mt.filterTimeout, c)
+}
+
+// filterConn rejects c if it's already in conns or any of the filters rejects
+// it, and adds it to conns otherwise. c is closed if it's rejected.
+func filterConn(
+	conns ConnSet,
+	filters []ConnFilterFunc,
+	resolver IPResolver,
+	timeout time.Duration,
+	c net.Conn,
+) (err error) {
 	defer func() {
 		if err != nil {
 			_ = c.Close()
@@ -298,21 +387,21 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 	}()
 
 	// Reject if connection is already present.
-	if mt.conns.Has(c) {
+	if conns.Has(c) {
 		return ErrRejected{conn: c, isDuplicate: true}
 	}
 
 	// Resolve ips for incoming conn.
-	ips, err := resolveIPs(mt.resolver, c)
+	ips, err := resolveIPs(resolver, c)
 	if err != nil {
 		return err
 	}
 
-	errc := make(chan error, len(mt.connFilters))
+	errc := make(chan error, len(filters))
 
-	for _, f := range mt.connFilters {
+	for _, f := range filters {
 		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
-			errc <- f(mt.conns, c, ips)
+			errc <- f(conns, c, ips)
 		}(f, c, ips, errc)
 	}
 
@@ -322,13 +411,13 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 			if err != nil {
 				return ErrRejected{conn: c, err: err, isFiltered: true}
 			}
-		case <-time.After(mt.filterTimeout):
+		case <-time.After(timeout):
 			return ErrFilterTimeout{}
 		}
 
 	}
 
-	mt.conns.Set(c, ips)
+	conns.Set(c, ips)
 
 	return nil
 }
@@ -351,7 +440,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	ourNodeInfo := mt.getNodeInfo()
+	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, ourNodeInfo)
 	if err != nil {
 		return nil, nil, ErrRejected{
 			conn:          c,
@@ -360,8 +450,20 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	connID := PubKeyToID(secretConn.RemotePubKey())
+	if err := checkPeerNodeInfo(c, connID, ourNodeInfo, nodeInfo); err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+// checkPeerNodeInfo rejects the NodeInfo a peer sent over c during the
+// handshake if it's invalid, doesn't match the ID the peer authenticated the
+// connection with, is our own or is incompatible with ours.
+func checkPeerNodeInfo(c net.Conn, connID ID, ourNodeInfo, nodeInfo NodeInfo) error {
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -369,8 +471,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Ensure connection key matches self reported key.
-	if connID := PubKeyToID(secretConn.RemotePubKey()); connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if connID != nodeInfo.ID() {
+		return ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -383,8 +485,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -392,8 +494,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -401,7 +503,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -0,0 +1,36 @@
+// +build !quic
+
+package p2p
+
+import (
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+// quic-go needs a more recent Go than the rest of Tendermint, so the QUIC
+// transport is only built with the quic tag. Without it, NewQUICTransport
+// fails and QUIC addresses can't be listened on.
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) {}
+}
+
+// QUICTransport stands in for the QUIC transport, it can't be created.
+type QUICTransport struct {
+	Transport
+	transportLifecycle
+	nodeInfoSetter
+}
+
+// NewQUICTransport returns ErrQUICUnsupported.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+	options ...QUICTransportOption,
+) (*QUICTransport, error) {
+	return nil, ErrQUICUnsupported{}
+}
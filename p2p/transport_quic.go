@@ -0,0 +1,431 @@
+// +build quic
+
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	quic "github.com/quic-go/quic-go"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+const (
+	// quicALPN is the application protocol negotiated by TLS on QUIC
+	// connections.
+	quicALPN = "tendermint-p2p"
+
+	// quicLingerTimeout is how long a closed connection waits for the peer to
+	// close it too, see quicConn.Close.
+	quicLingerTimeout = 3 * time.Second
+)
+
+// quicAccept is the container to carry the upgraded connection and NodeInfo
+// from an asynchronously running routine to the Accept method.
+type quicAccept struct {
+	conn     *quicConn
+	nodeInfo NodeInfo
+	err      error
+}
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransport accepts and dials QUIC connections and upgrades them to peers
+// sending the messages of each channel on a QUIC stream of its own, instead of
+// multiplexing them over a single connection with an MConnection. Peers are
+// authenticated by TLS with a certificate for their node key, so there's no
+// SecretConnection either. Only QUIC addresses are listened on and dialed.
+type QUICTransport struct {
+	listener *quic.Listener
+
+	acceptc chan quicAccept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeInfoMtx      sync.RWMutex
+	nodeKey          NodeKey
+	resolver         IPResolver
+	tlsConfig        *tls.Config
+	quicConfig       *quic.Config
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+var _ nodeInfoSetter = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport for the node key, which must be
+// an ed25519 key. The connections are kept alive with the ping interval of
+// mConfig, and closed if the peer isn't heard from within the pong timeout.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+	options ...QUICTransportOption,
+) (*QUICTransport, error) {
+	tlsConfig, err := quicTLSConfig(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	qt := &QUICTransport{
+		acceptc:          make(chan quicAccept),
+		closec:           make(chan struct{}),
+		conns:            NewConnSet(),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		resolver:         net.DefaultResolver,
+		tlsConfig:        tlsConfig,
+		quicConfig: &quic.Config{
+			HandshakeIdleTimeout: defaultHandshakeTimeout,
+			KeepAlivePeriod:      mConfig.PingInterval,
+			MaxIdleTimeout:       mConfig.PingInterval + mConfig.PongTimeout,
+		},
+	}
+	for _, option := range options {
+		option(qt)
+	}
+	return qt, nil
+}
+
+// SetNodeInfo updates the NodeInfo sent to peers during the handshake.
+func (qt *QUICTransport) SetNodeInfo(nodeInfo NodeInfo) {
+	qt.nodeInfoMtx.Lock()
+	defer qt.nodeInfoMtx.Unlock()
+	qt.nodeInfo = nodeInfo
+}
+
+func (qt *QUICTransport) getNodeInfo() NodeInfo {
+	qt.nodeInfoMtx.RLock()
+	defer qt.nodeInfoMtx.RUnlock()
+	return qt.nodeInfo
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-qt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return newQUICPeer(a.conn, a.nodeInfo, cfg, nil), nil
+	case <-qt.closec:
+		return nil, &ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (qt *QUICTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	if addr.TransportProtocol() != ProtocolQUIC {
+		return nil, fmt.Errorf("unsupported protocol %q", addr.Protocol)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout)
+	defer cancel()
+
+	c, err := quic.DialAddr(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	qc := newQUICConn(c)
+
+	if err := qt.filterConn(qc); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(qc, true)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return newQUICPeer(qc, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		return qt.listener.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	if addr.TransportProtocol() != ProtocolQUIC {
+		return fmt.Errorf("unsupported protocol %q", addr.Protocol)
+	}
+
+	ln, err := quic.ListenAddr(addr.DialString(), qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		c, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- quicAccept{err: err}
+			return
+		}
+
+		// Connection upgrade and filtering should be asynchronous to avoid
+		// Head-of-line blocking, see MultiplexTransport.acceptPeers.
+		go func(c *quicConn) {
+			var nodeInfo NodeInfo
+
+			err := qt.filterConn(c)
+			if err == nil {
+				nodeInfo, err = qt.upgrade(c, false)
+			}
+
+			select {
+			case qt.acceptc <- quicAccept{c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = c.Close()
+				return
+			}
+		}(newQUICConn(c))
+	}
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (qt *QUICTransport) Cleanup(peer Peer) {
+	qt.conns.RemoveAddr(peer.RemoteAddr())
+	_ = peer.CloseConn()
+}
+
+func (qt *QUICTransport) cleanup(c net.Conn) error {
+	qt.conns.Remove(c)
+
+	return c.Close()
+}
+
+func (qt *QUICTransport) filterConn(c net.Conn) error {
+	return filterConn(qt.conns, qt.connFilters, qt.resolver, qt.filterTimeout, c)
+}
+
+// upgrade opens the control stream of c if we dialed it, or accepts it
+// otherwise, and exchanges NodeInfo with the peer over it.
+func (qt *QUICTransport) upgrade(c *quicConn, outbound bool) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	connID, err := quicRemoteID(c.conn)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           err,
+			isAuthFailure: true,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+	defer cancel()
+	if outbound {
+		c.Stream, err = c.conn.OpenStreamSync(ctx)
+	} else {
+		c.Stream, err = c.conn.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("control stream failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	ourNodeInfo := qt.getNodeInfo()
+	nodeInfo, err = handshake(c, qt.handshakeTimeout, ourNodeInfo)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	if err := checkPeerNodeInfo(c, connID, ourNodeInfo, nodeInfo); err != nil {
+		return nil, err
+	}
+
+	return nodeInfo, nil
+}
+
+// quicTLSConfig returns the TLS config of QUIC connections, presenting a
+// self-signed certificate for the node key. The certificates of peers aren't
+// verified against any authority: TLS proves the peer holds the key of its
+// certificate, from which we derive its ID, see quicRemoteID.
+func quicTLSConfig(nodeKey NodeKey) (*tls.Config, error) {
+	privKey, ok := nodeKey.PrivKey.(ed25519.PrivKeyEd25519)
+	if !ok {
+		return nil, fmt.Errorf("QUIC needs an ed25519 node key, got %T", nodeKey.PrivKey)
+	}
+	key := stded25519.PrivateKey(privKey[:])
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Unix(0, 0),
+		NotAfter:     time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{cert},
+			PrivateKey:  key,
+		}},
+		ClientAuth:         tls.RequireAnyClientCert,
+		InsecureSkipVerify: true, // nolint: gosec
+		MinVersion:         tls.VersionTLS13,
+		NextProtos:         []string{quicALPN},
+	}, nil
+}
+
+// quicRemoteID returns the ID of the node key the peer's TLS certificate is
+// for.
+func quicRemoteID(c *quic.Conn) (ID, error) {
+	certs := c.ConnectionState().TLS.PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	key, ok := certs[0].PublicKey.(stded25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("peer certificate has a %T key, want ed25519", certs[0].PublicKey)
+	}
+
+	var pubKey ed25519.PubKeyEd25519
+	copy(pubKey[:], key)
+	return PubKeyToID(pubKey), nil
+}
+
+// quicConn is a net.Conn over the control stream of a QUIC connection, on
+// which peers exchange their NodeInfo. Closing it closes the connection.
+type quicConn struct {
+	*quic.Stream // nil until the connection is upgraded
+
+	conn *quic.Conn
+
+	// ctx is cancelled once the connection is closed by us.
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+func newQUICConn(c *quic.Conn) *quicConn {
+	ctx, cancel := context.WithCancel(c.Context())
+	return &quicConn{conn: c, ctx: ctx, cancel: cancel}
+}
+
+// Read implements net.Conn.
+func (c *quicConn) Read(b []byte) (int, error) {
+	n, err := c.Stream.Read(b)
+	return n, quicEOF(err)
+}
+
+// Close implements net.Conn. Closing a QUIC connection discards the data
+// not yet received by the peer, so unless the connection isn't upgraded yet,
+// only the control stream is closed at first. The connection is closed once
+// the peer closed its end of the control stream too, or after
+// quicLingerTimeout.
+func (c *quicConn) Close() (err error) {
+	c.closeOnce.Do(func() {
+		c.cancel()
+
+		if c.Stream == nil {
+			err = c.conn.CloseWithError(0, "")
+			return
+		}
+
+		err = c.Stream.Close()
+		go func() {
+			_ = c.Stream.SetReadDeadline(time.Now().Add(quicLingerTimeout))
+			_, _ = io.Copy(ioutil.Discard, c.Stream)
+			_ = c.conn.CloseWithError(0, "")
+		}()
+	})
+	return err
+}
+
+// LocalAddr implements net.Conn.
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr implements net.Conn.
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// quicEOF returns io.EOF for the error of reading from a connection closed
+// by the peer without error, and err otherwise.
+func quicEOF(err error) error {
+	if appErr, ok := err.(*quic.ApplicationError); ok && appErr.Remote && appErr.ErrorCode == 0 {
+		return io.EOF
+	}
+	return err
+}
@@ -0,0 +1,218 @@
+// +build quic
+
+package p2p
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p/conn"
+)
+
+func TestQUICTransportStreamPerChannel(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	defer qt.Close()
+
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: byte(0x01), Priority: 1},
+		{ID: byte(0x02), Priority: 1},
+	}
+	newPeerConfig := func(reactor *TestReactor) peerConfig {
+		return peerConfig{
+			chDescs:      chDescs,
+			onPeerError:  func(p Peer, r interface{}) {},
+			reactorsByCh: map[byte]Reactor{0x01: reactor, 0x02: reactor},
+		}
+	}
+	dialerReactor := NewTestReactor(chDescs, true)
+	listenerReactor := NewTestReactor(chDescs, true)
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		dialer = testQUICTransport(t, pv)
+	)
+	dialer.SetNodeInfo(testQUICNodeInfo(PubKeyToID(pv.PubKey()), "dialer"))
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := qt.Accept(newPeerConfig(listenerReactor))
+		require.NoError(t, err)
+		acceptc <- p
+	}()
+
+	dialed, err := dialer.Dial(*testQUICListenAddr(qt), newPeerConfig(dialerReactor))
+	require.NoError(t, err)
+	accepted := <-acceptc
+
+	for _, p := range []Peer{dialed, accepted} {
+		p.SetLogger(log.TestingLogger())
+		require.NoError(t, p.Start())
+		defer p.Stop()
+	}
+
+	// messages of either channel, in either direction, are delivered to the
+	// reactor over the stream of their channel
+	require.True(t, dialed.Send(0x02, []byte("channel two")))
+	require.True(t, dialed.Send(0x01, []byte("channel one")))
+	require.True(t, accepted.Send(0x01, []byte("back")))
+
+	assertMsgReceivedWithTimeout(t, []byte("channel two"), 0x02, listenerReactor, 10*time.Millisecond, 5*time.Second)
+	assertMsgReceivedWithTimeout(t, []byte("channel one"), 0x01, listenerReactor, 10*time.Millisecond, 5*time.Second)
+	assertMsgReceivedWithTimeout(t, []byte("back"), 0x01, dialerReactor, 10*time.Millisecond, 5*time.Second)
+}
+
+// serialReactor counts the messages it receives, and those received while
+// another one was being received.
+type serialReactor struct {
+	*TestReactor
+
+	receiving  int32
+	received   int32
+	concurrent int32
+}
+
+func (r *serialReactor) Receive(chID byte, peer Peer, msgBytes []byte) {
+	if atomic.AddInt32(&r.receiving, 1) > 1 {
+		atomic.AddInt32(&r.concurrent, 1)
+	}
+	time.Sleep(time.Millisecond)
+	atomic.AddInt32(&r.receiving, -1)
+	atomic.AddInt32(&r.received, 1)
+}
+
+func TestQUICPeerReceivesOneMessageAtATime(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	defer qt.Close()
+
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: byte(0x01), Priority: 1},
+		{ID: byte(0x02), Priority: 1},
+	}
+	newPeerConfig := func(reactor Reactor) peerConfig {
+		return peerConfig{
+			chDescs:      chDescs,
+			onPeerError:  func(p Peer, r interface{}) {},
+			reactorsByCh: map[byte]Reactor{0x01: reactor, 0x02: reactor},
+		}
+	}
+	reactor := &serialReactor{TestReactor: NewTestReactor(chDescs, false)}
+
+	pv := ed25519.GenPrivKey()
+	dialer := testQUICTransport(t, pv)
+	dialer.SetNodeInfo(testQUICNodeInfo(PubKeyToID(pv.PubKey()), "dialer"))
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := qt.Accept(newPeerConfig(reactor))
+		require.NoError(t, err)
+		acceptc <- p
+	}()
+
+	dialed, err := dialer.Dial(*testQUICListenAddr(qt), newPeerConfig(NewTestReactor(chDescs, false)))
+	require.NoError(t, err)
+	accepted := <-acceptc
+
+	for _, p := range []Peer{dialed, accepted} {
+		p.SetLogger(log.TestingLogger())
+		require.NoError(t, p.Start())
+		defer p.Stop()
+	}
+
+	// the messages of both channels arrive on streams read concurrently, but
+	// are passed to the reactor one at a time
+	const n = 50
+	for i := 0; i < n; i++ {
+		require.True(t, dialed.Send(0x01, []byte("one")))
+		require.True(t, dialed.Send(0x02, []byte("two")))
+	}
+	timeout := time.After(5 * time.Second)
+	for atomic.LoadInt32(&reactor.received) < 2*n {
+		select {
+		case <-timeout:
+			t.Fatalf("expected %d messages, received %d", 2*n, atomic.LoadInt32(&reactor.received))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	assert.Zero(t, atomic.LoadInt32(&reactor.concurrent))
+}
+
+func TestQUICTransportRejectMissmatchID(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	defer qt.Close()
+
+	errc := make(chan error)
+
+	go func() {
+		dialer := testQUICTransport(t, ed25519.GenPrivKey())
+		dialer.SetNodeInfo(testQUICNodeInfo(PubKeyToID(ed25519.GenPrivKey().PubKey()), "dialer"))
+
+		_, err := dialer.Dial(*testQUICListenAddr(qt), peerConfig{})
+		errc <- err
+	}()
+
+	if err := <-errc; err != nil {
+		t.Errorf("connection failed: %v", err)
+	}
+
+	_, err := qt.Accept(peerConfig{})
+	if err, ok := err.(ErrRejected); ok {
+		if !err.IsAuthFailure() {
+			t.Errorf("expected auth failure")
+		}
+	} else {
+		t.Errorf("expected ErrRejected")
+	}
+}
+
+func TestQUICTransportDialUnsupportedProtocol(t *testing.T) {
+	dialer := testQUICTransport(t, ed25519.GenPrivKey())
+
+	addr, err := NewNetAddressStringWithOptionalID("ws://127.0.0.1:26656")
+	require.NoError(t, err)
+
+	_, err = dialer.Dial(*addr, peerConfig{})
+	assert.Error(t, err)
+	assert.Error(t, dialer.Listen(*addr))
+}
+
+// testQUICListenAddr returns the QUIC address the transport listens on.
+func testQUICListenAddr(qt *QUICTransport) *NetAddress {
+	addr := NewNetAddress(qt.getNodeInfo().ID(), qt.listener.Addr())
+	addr.Protocol = ProtocolQUIC
+	return addr
+}
+
+func testQUICNodeInfo(id ID, name string) NodeInfo {
+	ni := testNodeInfo(id, name).(DefaultNodeInfo)
+	ni.ListenAddr = withProtocol(ProtocolQUIC, ni.ListenAddr)
+	ni.Channels = []byte{0x01, 0x02}
+	return ni
+}
+
+func testQUICTransport(t *testing.T, pv ed25519.PrivKeyEd25519) *QUICTransport {
+	qt, err := NewQUICTransport(
+		testQUICNodeInfo(PubKeyToID(pv.PubKey()), "transport"),
+		NodeKey{
+			PrivKey: pv,
+		},
+		conn.DefaultMConnConfig(),
+	)
+	require.NoError(t, err)
+	return qt
+}
+
+func testSetupQUICTransport(t *testing.T) *QUICTransport {
+	qt := testQUICTransport(t, ed25519.GenPrivKey())
+
+	addr, err := NewNetAddressStringWithOptionalID("quic://127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+
+	return qt
+}
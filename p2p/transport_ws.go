@@ -0,0 +1,188 @@
+package p2p
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsHTTPTimeout bounds reading, writing and idling of the HTTP requests
+// upgraded to WebSocket connections. Upgraded connections are hijacked from
+// the server, which clears their deadlines.
+const wsHTTPTimeout = 10 * time.Second
+
+// wsNetwork is a TransportNetwork carrying connections over WebSocket, so
+// nodes can be reached through proxies and firewalls only letting HTTP
+// through. Each connection is upgraded to a SecretConnection and multiplexed
+// with an MConnection the same as TCP connections.
+type wsNetwork struct{}
+
+var _ TransportNetwork = wsNetwork{}
+
+func (wsNetwork) Listen(addr NetAddress) (net.Listener, error) {
+	ln, err := net.Listen("tcp", addr.DialString())
+	if err != nil {
+		return nil, err
+	}
+
+	wl := &wsListener{
+		ln:     ln,
+		connc:  make(chan net.Conn),
+		closec: make(chan struct{}),
+	}
+	srv := &http.Server{
+		Handler:      wl,
+		ReadTimeout:  wsHTTPTimeout,
+		WriteTimeout: wsHTTPTimeout,
+		IdleTimeout:  wsHTTPTimeout,
+	}
+	go srv.Serve(ln) // nolint: errcheck
+
+	return wl, nil
+}
+
+func (wsNetwork) Dial(addr NetAddress, timeout time.Duration) (net.Conn, error) {
+	dialer := websocket.Dialer{
+		HandshakeTimeout: timeout,
+		NetDial: func(network, hostPort string) (net.Conn, error) {
+			return net.DialTimeout(network, hostPort, timeout)
+		},
+	}
+	c, _, err := dialer.Dial("ws://"+addr.DialString()+"/", nil)
+	if err != nil {
+		return nil, err
+	}
+	return newWSConn(c), nil
+}
+
+// wsListener is a net.Listener handing out the WebSocket connections
+// upgraded by its HTTP server.
+type wsListener struct {
+	ln        net.Listener
+	connc     chan net.Conn
+	closec    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ net.Listener = (*wsListener)(nil)
+
+var wsUpgrader = websocket.Upgrader{
+	// peers aren't browsers, there's no origin to check
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// ServeHTTP implements http.Handler by upgrading the request to a WebSocket
+// connection waiting to be accepted.
+func (wl *wsListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // Upgrade replied with an error
+	}
+
+	select {
+	case wl.connc <- newWSConn(c):
+	case <-wl.closec:
+		_ = c.Close()
+	}
+}
+
+// Accept implements net.Listener.
+func (wl *wsListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-wl.connc:
+		return c, nil
+	case <-wl.closec:
+		return nil, &net.OpError{Op: "accept", Net: "ws", Addr: wl.Addr(), Err: errWSListenerClosed}
+	}
+}
+
+// Close implements net.Listener.
+func (wl *wsListener) Close() error {
+	var err error
+	wl.closeOnce.Do(func() {
+		close(wl.closec)
+		err = wl.ln.Close()
+	})
+	return err
+}
+
+// Addr implements net.Listener.
+func (wl *wsListener) Addr() net.Addr {
+	return wl.ln.Addr()
+}
+
+var errWSListenerClosed = errors.New("listener closed")
+
+// wsConn adapts a WebSocket connection to a net.Conn, sending writes as
+// binary messages and reading messages as one stream.
+type wsConn struct {
+	*websocket.Conn
+
+	reader io.Reader // current message
+	rmtx   sync.Mutex
+	wmtx   sync.Mutex
+}
+
+var _ net.Conn = (*wsConn)(nil)
+
+func newWSConn(c *websocket.Conn) *wsConn {
+	return &wsConn{Conn: c}
+}
+
+// Read implements net.Conn.
+func (c *wsConn) Read(b []byte) (int, error) {
+	c.rmtx.Lock()
+	defer c.rmtx.Unlock()
+
+	for {
+		if c.reader == nil {
+			messageType, r, err := c.NextReader()
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseAbnormalClosure) {
+				// the peer closed the connection, with or without a close
+				// message
+				return 0, io.EOF
+			}
+			if err != nil {
+				return 0, err
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			c.reader = r
+		}
+
+		n, err := c.reader.Read(b)
+		if err == io.EOF {
+			c.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Write implements net.Conn.
+func (c *wsConn) Write(b []byte) (int, error) {
+	c.wmtx.Lock()
+	defer c.wmtx.Unlock()
+
+	if err := c.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// SetDeadline implements net.Conn.
+func (c *wsConn) SetDeadline(t time.Time) error {
+	if err := c.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.SetWriteDeadline(t)
+}
@@ -240,6 +240,9 @@ func (pv *FilePV) SignVote(chainID string, vote *types.Vote) error {
 	if err := pv.signVote(chainID, vote); err != nil {
 		return fmt.Errorf("Error signing vote: %v", err)
 	}
+	if err := pv.signVoteExtension(chainID, vote); err != nil {
+		return fmt.Errorf("Error signing vote extension: %v", err)
+	}
 	return nil
 }
 
@@ -319,6 +322,21 @@ func (pv *FilePV) signVote(chainID string, vote *types.Vote) error {
 	return nil
 }
 
+// signVoteExtension sets the signature of the vote extension, even if empty,
+// for precommits for a block. The extension can't be used to double sign, so
+// it isn't checked against the last sign state.
+func (pv *FilePV) signVoteExtension(chainID string, vote *types.Vote) error {
+	if !vote.IsExtendable() {
+		return nil
+	}
+	sig, err := pv.Key.PrivKey.Sign(vote.ExtensionSignBytes(chainID))
+	if err != nil {
+		return err
+	}
+	vote.ExtensionSignature = sig
+	return nil
+}
+
 // signProposal checks if the proposal is good to sign and sets the proposal signature.
 // It may need to set the timestamp as well if the proposal is otherwise the same as
 // a previously signed proposal ie. we crashed after signing but before the proposal hit the WAL).
@@ -197,6 +197,40 @@ func TestSignVote(t *testing.T) {
 	assert.Equal(sig, vote.Signature)
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	assert := assert.New(t)
+
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	privVal := GenFilePV(tempKeyFile.Name(), tempStateFile.Name())
+
+	block := types.BlockID{[]byte{1, 2, 3}, types.PartSetHeader{}}
+	vote := newVote(privVal.Key.Address, 0, 10, 1, byte(types.PrecommitType), block)
+	vote.Extension = []byte("extension")
+	err = privVal.SignVote("mychainid", vote)
+	assert.NoError(err, "expected no error signing vote")
+	assert.NoError(vote.Verify("mychainid", privVal.GetPubKey()))
+
+	// re-signing the same vote with another extension only changes the extension signature
+	sig, extSig := vote.Signature, vote.ExtensionSignature
+	vote.Extension = []byte("other extension")
+	err = privVal.SignVote("mychainid", vote)
+	assert.NoError(err, "expected no error on signing same vote")
+	assert.Equal(sig, vote.Signature)
+	assert.NotEqual(extSig, vote.ExtensionSignature)
+	assert.NoError(vote.Verify("mychainid", privVal.GetPubKey()))
+
+	// empty extensions are signed too
+	vote = newVote(privVal.Key.Address, 0, 11, 1, byte(types.PrecommitType), block)
+	err = privVal.SignVote("mychainid", vote)
+	assert.NoError(err, "expected no error signing vote")
+	assert.NotEmpty(vote.ExtensionSignature)
+	assert.NoError(vote.VerifyExtension("mychainid", privVal.GetPubKey()))
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
@@ -136,6 +136,69 @@ func (c *HTTP) NetInfo() (*ctypes.ResultNetInfo, error) {
 	return result, nil
 }
 
+func (c *HTTP) CrawlReport() (*ctypes.ResultCrawlReport, error) {
+	result := new(ctypes.ResultCrawlReport)
+	_, err := c.rpc.Call("crawl_report", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "CrawlReport")
+	}
+	return result, nil
+}
+
+func (c *HTTP) DisconnectPeer(id, reason string) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_disconnect_peer", map[string]interface{}{"id": id, "reason": reason}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "DisconnectPeer")
+	}
+	return result, nil
+}
+
+func (c *HTTP) AddPersistentPeers(peers []string) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_add_persistent_peers", map[string]interface{}{"peers": peers}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddPersistentPeers")
+	}
+	return result, nil
+}
+
+func (c *HTTP) RemovePersistentPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_remove_persistent_peers", map[string]interface{}{"ids": ids}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "RemovePersistentPeers")
+	}
+	return result, nil
+}
+
+func (c *HTTP) AddUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_add_unconditional_peers", map[string]interface{}{"ids": ids}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddUnconditionalPeers")
+	}
+	return result, nil
+}
+
+func (c *HTTP) RemoveUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_remove_unconditional_peers", map[string]interface{}{"ids": ids}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "RemoveUnconditionalPeers")
+	}
+	return result, nil
+}
+
+func (c *HTTP) SetPeerPEX(id string, enabled bool) (*ctypes.ResultManagePeers, error) {
+	result := new(ctypes.ResultManagePeers)
+	_, err := c.rpc.Call("unsafe_set_peer_pex", map[string]interface{}{"id": id, "enabled": enabled}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "SetPeerPEX")
+	}
+	return result, nil
+}
+
 func (c *HTTP) DumpConsensusState() (*ctypes.ResultDumpConsensusState, error) {
 	result := new(ctypes.ResultDumpConsensusState)
 	_, err := c.rpc.Call("dump_consensus_state", map[string]interface{}{}, result)
@@ -145,6 +208,15 @@ func (c *HTTP) DumpConsensusState() (*ctypes.ResultDumpConsensusState, error) {
 	return result, nil
 }
 
+func (c *HTTP) Profile(name string, seconds int) (*ctypes.ResultProfile, error) {
+	result := new(ctypes.ResultProfile)
+	_, err := c.rpc.Call("profile", map[string]interface{}{"name": name, "seconds": seconds}, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "Profile")
+	}
+	return result, nil
+}
+
 func (c *HTTP) ConsensusState() (*ctypes.ResultConsensusState, error) {
 	result := new(ctypes.ResultConsensusState)
 	_, err := c.rpc.Call("consensus_state", map[string]interface{}{}, result)
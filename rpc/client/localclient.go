@@ -88,10 +88,18 @@ func (Local) NetInfo() (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo()
 }
 
+func (Local) CrawlReport() (*ctypes.ResultCrawlReport, error) {
+	return core.CrawlReport()
+}
+
 func (Local) DumpConsensusState() (*ctypes.ResultDumpConsensusState, error) {
 	return core.DumpConsensusState()
 }
 
+func (Local) Profile(name string, seconds int) (*ctypes.ResultProfile, error) {
+	return core.Profile(name, seconds)
+}
+
 func (Local) ConsensusState() (*ctypes.ResultConsensusState, error) {
 	return core.ConsensusState()
 }
@@ -108,6 +116,46 @@ func (Local) DialPeers(peers []string, persistent bool) (*ctypes.ResultDialPeers
 	return core.UnsafeDialPeers(peers, persistent)
 }
 
+func (Local) DisconnectPeer(id, reason string) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeDisconnectPeer(id, reason)
+}
+
+func (Local) AddPersistentPeers(peers []string) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeAddPersistentPeers(peers)
+}
+
+func (Local) RemovePersistentPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeRemovePersistentPeers(ids)
+}
+
+func (Local) AddUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeAddUnconditionalPeers(ids)
+}
+
+func (Local) RemoveUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeRemoveUnconditionalPeers(ids)
+}
+
+func (Local) SetPeerPEX(id string, enabled bool) (*ctypes.ResultManagePeers, error) {
+	return core.UnsafeSetPeerPEX(id, enabled)
+}
+
+func (Local) Bans() (*ctypes.ResultBans, error) {
+	return core.UnsafeBans()
+}
+
+func (Local) BanPeer(id string, seconds int, reason string) (*ctypes.ResultBanPeer, error) {
+	return core.UnsafeBanPeer(id, seconds, reason)
+}
+
+func (Local) UnbanPeer(id string) (*ctypes.ResultUnbanPeer, error) {
+	return core.UnsafeUnbanPeer(id)
+}
+
+func (Local) SetHalt(height, time int64) (*ctypes.ResultSetHalt, error) {
+	return core.UnsafeSetHalt(height, time)
+}
+
 func (Local) BlockchainInfo(minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(minHeight, maxHeight)
 }
@@ -14,6 +14,7 @@ var node *nm.Node
 func TestMain(m *testing.M) {
 	// start a tendermint node (and kvstore) in the background to test against
 	app := kvstore.NewKVStoreApplication()
+	rpctest.GetConfig().RPC.Unsafe = true // for the peer management routes
 	node = rpctest.StartTendermint(app)
 	code := m.Run()
 
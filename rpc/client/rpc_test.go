@@ -12,6 +12,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tendermint/tendermint/rpc/test"
 	"github.com/tendermint/tendermint/types"
 )
@@ -82,6 +83,60 @@ func TestNetInfo(t *testing.T) {
 	}
 }
 
+// peerManager is implemented by the clients which expose the unsafe peer
+// management routes.
+type peerManager interface {
+	DisconnectPeer(id, reason string) (*ctypes.ResultManagePeers, error)
+	AddPersistentPeers(peers []string) (*ctypes.ResultManagePeers, error)
+	RemovePersistentPeers(ids []string) (*ctypes.ResultManagePeers, error)
+	AddUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error)
+	RemoveUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error)
+	SetPeerPEX(id string, enabled bool) (*ctypes.ResultManagePeers, error)
+}
+
+func TestManagePeers(t *testing.T) {
+	const (
+		id      = "f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"
+		otherID = "0123456789abcdef0123456789abcdef01234567"
+	)
+	for i, c := range GetClients() {
+		pm, ok := c.(peerManager)
+		require.True(t, ok, "%d", i)
+
+		// the peer is not connected
+		_, err := pm.DisconnectPeer(id, "test")
+		assert.NotNil(t, err, "%d", i)
+		_, err = pm.SetPeerPEX(id, false)
+		require.Nil(t, err, "%d: %+v", i, err)
+		_, err = pm.SetPeerPEX(id, true)
+		require.Nil(t, err, "%d: %+v", i, err)
+		_, err = pm.SetPeerPEX("invalid", true)
+		assert.NotNil(t, err, "%d", i)
+
+		_, err = pm.AddPersistentPeers([]string{id + "@127.0.0.1:1"})
+		require.Nil(t, err, "%d: %+v", i, err)
+		// one of the peers is not persistent, so none is removed
+		_, err = pm.RemovePersistentPeers([]string{id, otherID})
+		assert.NotNil(t, err, "%d", i)
+		_, err = pm.RemovePersistentPeers([]string{id})
+		require.Nil(t, err, "%d: %+v", i, err)
+		_, err = pm.RemovePersistentPeers([]string{id})
+		assert.NotNil(t, err, "%d", i)
+
+		_, err = pm.AddUnconditionalPeers([]string{id})
+		require.Nil(t, err, "%d: %+v", i, err)
+		// one of the peers is not unconditional, so none is removed
+		_, err = pm.RemoveUnconditionalPeers([]string{id, otherID})
+		assert.NotNil(t, err, "%d", i)
+		_, err = pm.RemoveUnconditionalPeers([]string{id})
+		require.Nil(t, err, "%d: %+v", i, err)
+		_, err = pm.RemoveUnconditionalPeers([]string{id})
+		assert.NotNil(t, err, "%d", i)
+		_, err = pm.AddUnconditionalPeers([]string{"invalid"})
+		assert.NotNil(t, err, "%d", i)
+	}
+}
+
 func TestDumpConsensusState(t *testing.T) {
 	for i, c := range GetClients() {
 		// FIXME: fix server so it doesn't panic on invalid input
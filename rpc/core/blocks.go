@@ -323,7 +323,7 @@ func Commit(heightPtr *int64) (*ctypes.ResultCommit, error) {
 	// If the next block has not been committed yet,
 	// use a non-canonical commit
 	if height == storeHeight {
-		commit := blockStore.LoadSeenCommit(height)
+		commit := blockStore.LoadSeenCommit(height).StripExtensions()
 		return ctypes.NewResultCommit(&header, commit, false), nil
 	}
 
@@ -1,6 +1,11 @@
 package core
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
 	cm "github.com/tendermint/tendermint/consensus"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	sm "github.com/tendermint/tendermint/state"
@@ -329,3 +334,27 @@ func ConsensusParams(heightPtr *int64) (*ctypes.ResultConsensusParams, error) {
 	}
 	return &ctypes.ResultConsensusParams{BlockHeight: height, ConsensusParams: consensusparams}, nil
 }
+
+// UnsafeSetHalt sets the height and UNIX time (in seconds) after which the
+// node stops committing blocks and exits, eg. for a coordinated upgrade.
+// Zero values disable them. It overrides halt_height and halt_time from the
+// config until the node restarts.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_set_halt?height=1000&time=0'
+// ```
+func UnsafeSetHalt(height, unixTime int64) (*ctypes.ResultSetHalt, error) {
+	if height < 0 || unixTime < 0 {
+		return nil, errors.New("height and time can't be negative")
+	}
+	if lastHeight := blockStore.Height(); height != 0 && height <= lastHeight {
+		return nil, fmt.Errorf("height must be greater than the latest height %d", lastHeight)
+	}
+	var t time.Time
+	if unixTime != 0 {
+		t = time.Unix(unixTime, 0)
+	}
+	logger.Info("SetHalt", "height", height, "time", t)
+	halter.SetHalt(height, t)
+	return &ctypes.ResultSetHalt{HaltHeight: height, HaltTime: t}, nil
+}
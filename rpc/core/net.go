@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -36,7 +38,9 @@ import (
 // 		"listeners": [
 // 			"Listener(@10.0.2.15:26656)"
 // 		],
-// 		"listening": true
+// 		"listening": true,
+// 		"persistent_peers": [],
+// 		"unconditional_peer_ids": []
 // 	},
 // 	"id": "",
 // 	"jsonrpc": "2.0"
@@ -52,21 +56,76 @@ func NetInfo() (*ctypes.ResultNetInfo, error) {
 		peers = append(peers, ctypes.Peer{
 			NodeInfo:         nodeInfo,
 			IsOutbound:       peer.IsOutbound(),
+			IsPersistent:     p2pPeers.IsPeerPersistent(peer.ID()),
+			IsUnconditional:  p2pPeers.IsPeerUnconditional(peer.ID()),
+			PEXEnabled:       pexReactor != nil && pexReactor.IsPeerPEXEnabled(peer.ID()),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP(),
 		})
 	}
-	// TODO: Should we include PersistentPeers and Seeds in here?
+	persistentPeers := []string{}
+	for _, addr := range p2pPeers.PersistentPeers() {
+		persistentPeers = append(persistentPeers, addr.String())
+	}
+	sort.Strings(persistentPeers)
+	unconditionalPeerIDs := []string{}
+	for _, id := range p2pPeers.UnconditionalPeerIDs() {
+		unconditionalPeerIDs = append(unconditionalPeerIDs, string(id))
+	}
+	sort.Strings(unconditionalPeerIDs)
+	// TODO: Should we include Seeds in here?
 	// PRO: useful info
 	// CON: privacy
 	return &ctypes.ResultNetInfo{
-		Listening: p2pTransport.IsListening(),
-		Listeners: p2pTransport.Listeners(),
-		NPeers:    len(peers),
-		Peers:     peers,
+		Listening:            p2pTransport.IsListening(),
+		Listeners:            p2pTransport.Listeners(),
+		NPeers:               len(peers),
+		Peers:                peers,
+		PersistentPeers:      persistentPeers,
+		UnconditionalPeerIDs: unconditionalPeerIDs,
 	}, nil
 }
 
+// CrawlReport returns the reachability history, node info and latency of
+// every address discovered by the crawler, most reachable first. Only
+// available on nodes running in seed mode.
+//
+// ```shell
+// curl 'localhost:26657/crawl_report'
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// report, err := client.CrawlReport()
+// ```
+func CrawlReport() (*ctypes.ResultCrawlReport, error) {
+	if pexReactor == nil {
+		return nil, errors.New("PEX reactor is disabled")
+	}
+	records, err := pexReactor.CrawlReport()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]ctypes.CrawledAddress, 0, len(records))
+	for _, rec := range records {
+		addrs = append(addrs, ctypes.CrawledAddress{
+			Addr:        rec.Addr,
+			NodeInfo:    rec.NodeInfo,
+			Attempts:    rec.Attempts,
+			Successes:   rec.Successes,
+			Latency:     rec.Latency(),
+			LastAttempt: rec.LastAttempt,
+			LastSuccess: rec.LastSuccess,
+		})
+	}
+	return &ctypes.ResultCrawlReport{Addresses: addrs}, nil
+}
+
 func UnsafeDialSeeds(seeds []string) (*ctypes.ResultDialSeeds, error) {
 	if len(seeds) == 0 {
 		return &ctypes.ResultDialSeeds{}, errors.New("No seeds provided")
@@ -93,6 +152,192 @@ func UnsafeDialPeers(peers []string, persistent bool) (*ctypes.ResultDialPeers,
 	return &ctypes.ResultDialPeers{"Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeDisconnectPeer disconnects the peer with the given ID, as if it had
+// errored. Persistent peers are reconnected to.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_disconnect_peer?id="f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"&reason="maintenance"'
+// ```
+func UnsafeDisconnectPeer(id string, reason string) (*ctypes.ResultManagePeers, error) {
+	peerID, err := p2p.ParseID(id)
+	if err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	peer := p2pPeers.Peers().Get(peerID)
+	if peer == nil {
+		return &ctypes.ResultManagePeers{}, fmt.Errorf("peer %v is not connected", peerID)
+	}
+	logger.Info("DisconnectPeer", "id", peerID, "reason", reason)
+	p2pPeers.StopPeerForError(peer, fmt.Errorf("disconnected via RPC: %s", reason))
+	return &ctypes.ResultManagePeers{Log: fmt.Sprintf("Disconnected peer %v", peerID)}, nil
+}
+
+// UnsafeAddPersistentPeers makes the given peers persistent and dials those
+// which aren't connected.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_add_persistent_peers?peers=\["f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4@1.2.3.4:26656"\]'
+// ```
+func UnsafeAddPersistentPeers(peers []string) (*ctypes.ResultManagePeers, error) {
+	if len(peers) == 0 {
+		return &ctypes.ResultManagePeers{}, errors.New("No peers provided")
+	}
+	netAddrs, errs := p2p.NewNetAddressStrings(peers)
+	if len(errs) > 0 {
+		return &ctypes.ResultManagePeers{}, errs[0]
+	}
+	logger.Info("AddPersistentPeers", "peers", peers)
+	for _, addr := range netAddrs {
+		p2pPeers.AddPersistentPeer(addr)
+	}
+	if err := p2pPeers.DialPeersAsync(addrBook, peers, true); err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	return &ctypes.ResultManagePeers{Log: "Added persistent peers. See /net_info for details"}, nil
+}
+
+// UnsafeRemovePersistentPeers stops reconnecting to the peers with the given
+// IDs. They are not disconnected.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_remove_persistent_peers?ids=\["f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"\]'
+// ```
+func UnsafeRemovePersistentPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	peerIDs, err := parsePeerIDs(ids)
+	if err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	logger.Info("RemovePersistentPeers", "ids", ids)
+	// check them all first, so that none is removed on error
+	for _, id := range peerIDs {
+		if !p2pPeers.IsPeerPersistent(id) {
+			return &ctypes.ResultManagePeers{}, fmt.Errorf("peer %v is not persistent", id)
+		}
+	}
+	for _, id := range peerIDs {
+		p2pPeers.RemovePersistentPeer(id)
+	}
+	return &ctypes.ResultManagePeers{Log: "Removed persistent peers. See /net_info for details"}, nil
+}
+
+// UnsafeAddUnconditionalPeers exempts the peers with the given IDs from the
+// limits on the number of peers.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_add_unconditional_peers?ids=\["f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"\]'
+// ```
+func UnsafeAddUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	peerIDs, err := parsePeerIDs(ids)
+	if err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	logger.Info("AddUnconditionalPeers", "ids", ids)
+	for _, id := range peerIDs {
+		p2pPeers.AddUnconditionalPeer(id)
+	}
+	return &ctypes.ResultManagePeers{Log: "Added unconditional peers. See /net_info for details"}, nil
+}
+
+// UnsafeRemoveUnconditionalPeers subjects the peers with the given IDs to the
+// limits on the number of peers again.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_remove_unconditional_peers?ids=\["f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"\]'
+// ```
+func UnsafeRemoveUnconditionalPeers(ids []string) (*ctypes.ResultManagePeers, error) {
+	peerIDs, err := parsePeerIDs(ids)
+	if err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	logger.Info("RemoveUnconditionalPeers", "ids", ids)
+	// check them all first, so that none is removed on error
+	for _, id := range peerIDs {
+		if !p2pPeers.IsPeerUnconditional(id) {
+			return &ctypes.ResultManagePeers{}, fmt.Errorf("peer %v is not unconditional", id)
+		}
+	}
+	for _, id := range peerIDs {
+		p2pPeers.RemoveUnconditionalPeer(id)
+	}
+	return &ctypes.ResultManagePeers{Log: "Removed unconditional peers. See /net_info for details"}, nil
+}
+
+// UnsafeSetPeerPEX enables or disables the exchange of addresses with the peer
+// with the given ID.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_set_peer_pex?id="f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"&enabled=false'
+// ```
+func UnsafeSetPeerPEX(id string, enabled bool) (*ctypes.ResultManagePeers, error) {
+	if pexReactor == nil {
+		return &ctypes.ResultManagePeers{}, errors.New("PEX reactor is disabled")
+	}
+	peerID, err := p2p.ParseID(id)
+	if err != nil {
+		return &ctypes.ResultManagePeers{}, err
+	}
+	pexReactor.SetPeerPEXEnabled(peerID, enabled)
+	return &ctypes.ResultManagePeers{Log: fmt.Sprintf("Set PEX enabled to %v for peer %v", enabled, peerID)}, nil
+}
+
+// UnsafeBans lists the peer IDs banned by the address book.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_bans'
+// ```
+func UnsafeBans() (*ctypes.ResultBans, error) {
+	return &ctypes.ResultBans{Bans: addrBook.ListOfBans()}, nil
+}
+
+// UnsafeBanPeer bans a peer ID for the given number of seconds, or
+// permanently if seconds is 0. The peer is disconnected if connected, and
+// the address book refuses its addresses until the ban expires.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_ban_peer?id="f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"&seconds=3600&reason="spam"'
+// ```
+func UnsafeBanPeer(id string, seconds int, reason string) (*ctypes.ResultBanPeer, error) {
+	peerID, err := p2p.ParseID(id)
+	if err != nil {
+		return &ctypes.ResultBanPeer{}, err
+	}
+	if seconds < 0 {
+		return &ctypes.ResultBanPeer{}, fmt.Errorf("seconds must be non-negative, got %d", seconds)
+	}
+	logger.Info("BanPeer", "id", peerID, "seconds", seconds, "reason", reason)
+	addrBook.Ban(peerID, time.Duration(seconds)*time.Second, reason)
+	if peer := p2pPeers.Peers().Get(peerID); peer != nil {
+		p2pPeers.StopPeerForError(peer, fmt.Errorf("banned: %s", reason))
+	}
+	return &ctypes.ResultBanPeer{Log: fmt.Sprintf("Banned peer %v", peerID)}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of a peer ID.
+//
+// ```shell
+// curl 'localhost:26657/unsafe_unban_peer?id="f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"'
+// ```
+func UnsafeUnbanPeer(id string) (*ctypes.ResultUnbanPeer, error) {
+	peerID, err := p2p.ParseID(id)
+	if err != nil {
+		return &ctypes.ResultUnbanPeer{}, err
+	}
+	logger.Info("UnbanPeer", "id", peerID)
+	if !addrBook.Unban(peerID) {
+		return &ctypes.ResultUnbanPeer{}, fmt.Errorf("peer %v is not banned", peerID)
+	}
+	return &ctypes.ResultUnbanPeer{Log: fmt.Sprintf("Unbanned peer %v", peerID)}, nil
+}
+
+// parsePeerIDs checks that ids are valid hex-encoded peer IDs, and that there
+// is at least one.
+func parsePeerIDs(ids []string) ([]p2p.ID, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("No peer IDs provided")
+	}
+	return p2p.ParseIDs(ids)
+}
+
 // Get genesis file.
 //
 // ```shell
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
 	"github.com/tendermint/tendermint/proxy"
 	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
 	sm "github.com/tendermint/tendermint/state"
@@ -43,6 +44,16 @@ type peers interface {
 	DialPeersAsync(p2p.AddrBook, []string, bool) error
 	NumPeers() (outbound, inbound, dialig int)
 	Peers() p2p.IPeerSet
+	StopPeerForError(p2p.Peer, interface{})
+
+	AddPersistentPeer(*p2p.NetAddress)
+	RemovePersistentPeer(p2p.ID) bool
+	IsPeerPersistent(p2p.ID) bool
+	PersistentPeers() []*p2p.NetAddress
+	AddUnconditionalPeer(p2p.ID)
+	RemoveUnconditionalPeer(p2p.ID) bool
+	IsPeerUnconditional(p2p.ID) bool
+	UnconditionalPeerIDs() []p2p.ID
 }
 
 //----------------------------------------------
@@ -57,6 +68,7 @@ var (
 	stateDB        dbm.DB
 	blockStore     sm.BlockStore
 	evidencePool   sm.EvidencePool
+	halter         *sm.Halter
 	consensusState Consensus
 	p2pPeers       peers
 	p2pTransport   transport
@@ -64,7 +76,8 @@ var (
 	// objects
 	pubKey           crypto.PubKey
 	genDoc           *types.GenesisDoc // cache the genesis structure
-	addrBook         p2p.AddrBook
+	addrBook         pex.AddrBook
+	pexReactor       *pex.PEXReactor // nil if PEX is disabled
 	txIndexer        txindex.TxIndexer
 	consensusReactor *consensus.ConsensusReactor
 	eventBus         *types.EventBus // thread safe
@@ -89,6 +102,10 @@ func SetEvidencePool(evpool sm.EvidencePool) {
 	evidencePool = evpool
 }
 
+func SetHalter(h *sm.Halter) {
+	halter = h
+}
+
 func SetConsensusState(cs Consensus) {
 	consensusState = cs
 }
@@ -109,10 +126,14 @@ func SetGenesisDoc(doc *types.GenesisDoc) {
 	genDoc = doc
 }
 
-func SetAddrBook(book p2p.AddrBook) {
+func SetAddrBook(book pex.AddrBook) {
 	addrBook = book
 }
 
+func SetPEXReactor(r *pex.PEXReactor) {
+	pexReactor = r
+}
+
 func SetProxyAppQuery(appConn proxy.AppConnQuery) {
 	proxyAppQuery = appConn
 }
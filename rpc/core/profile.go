@@ -0,0 +1,185 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+const (
+	// maxProfileBytes limits the size of a profile held in memory before it
+	// is returned to the client.
+	maxProfileBytes = 16 * 1024 * 1024 // 16MB
+
+	// rate at which block events are sampled while capturing a block profile
+	// (1 means every blocking event is recorded).
+	blockProfileRate = 1
+
+	// fraction of mutex contention events sampled while capturing a mutex
+	// profile.
+	mutexProfileFraction = 1
+)
+
+// maxProfileDuration must be less than the HTTP server's write timeout so that
+// the profile can be returned before the connection is closed.
+var maxProfileDuration = rpcserver.WriteTimeout / 2
+
+var (
+	// only one profile is captured at a time
+	profileMtx sync.Mutex
+	profiling  bool
+
+	// the runtime doesn't report the block profile rate, so it's tracked here
+	// to be restored after a block profile.
+	blockProfileRateMtx     sync.Mutex
+	currentBlockProfileRate int
+)
+
+// Capture a runtime profile of the node and return it in the response, in the
+// compressed protobuf format understood by `go tool pprof`. Nothing is written
+// to the node's filesystem.
+//
+// Supported profiles are "cpu", "heap", "goroutine", "mutex" and "block".
+// "cpu", "mutex" and "block" are sampled for the given number of seconds
+// (at most 10); "heap" and "goroutine" are snapshots and ignore `seconds`.
+// Only one profile can be captured at a time.
+//
+// Only available if `rpc.profiling` is enabled in the config.
+//
+// ```shell
+// curl 'localhost:26657/profile?name="cpu"&seconds=5'
+// ```
+//
+// ```go
+// client := client.NewHTTP("tcp://0.0.0.0:26657", "/websocket")
+// err := client.Start()
+// if err != nil {
+//   // handle error
+// }
+// defer client.Stop()
+// result, err := client.Profile("cpu", 5)
+// ```
+//
+// > The above command returns JSON structured like this:
+//
+// ```json
+// {
+// 	"error": "",
+// 	"result": {
+// 		"name": "cpu",
+// 		"seconds": 5,
+// 		"data": "H4sIAAAAAAAE/..."
+// 	},
+// 	"id": "",
+// 	"jsonrpc": "2.0"
+// }
+// ```
+//
+// ### Query Parameters
+//
+// | Parameter | Type   | Default | Required | Description                                 |
+// |-----------+--------+---------+----------+---------------------------------------------|
+// | name      | string | ""      | true     | Profile to capture                          |
+// | seconds   | int    | 0       | false    | Duration of sampled profiles (0 means 1s)   |
+//
+// `data` is the base64 encoded profile.
+func Profile(name string, seconds int) (*ctypes.ResultProfile, error) {
+	duration := time.Duration(seconds) * time.Second
+	if duration <= 0 {
+		duration = time.Second
+	}
+	if duration > maxProfileDuration {
+		return nil, fmt.Errorf("profile duration must be at most %v", maxProfileDuration)
+	}
+
+	profileMtx.Lock()
+	if profiling {
+		profileMtx.Unlock()
+		return nil, errors.New("a profile is already being captured")
+	}
+	profiling = true
+	profileMtx.Unlock()
+
+	defer func() {
+		profileMtx.Lock()
+		profiling = false
+		profileMtx.Unlock()
+	}()
+
+	buf := &limitedBuffer{limit: maxProfileBytes}
+
+	var err error
+	seconds = int(duration / time.Second)
+	switch name {
+	case "cpu":
+		err = captureCPUProfile(buf, duration)
+	case "heap", "goroutine":
+		seconds = 0
+		err = pprof.Lookup(name).WriteTo(buf, 0)
+	case "mutex":
+		prev := runtime.SetMutexProfileFraction(mutexProfileFraction)
+		time.Sleep(duration)
+		err = pprof.Lookup(name).WriteTo(buf, 0)
+		runtime.SetMutexProfileFraction(prev)
+	case "block":
+		prev := setBlockProfileRate(blockProfileRate)
+		time.Sleep(duration)
+		err = pprof.Lookup(name).WriteTo(buf, 0)
+		setBlockProfileRate(prev)
+	default:
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultProfile{Name: name, Seconds: seconds, Data: buf.Bytes()}, nil
+}
+
+// setBlockProfileRate sets the block profile rate like
+// runtime.SetBlockProfileRate and returns the previous rate, like
+// runtime.SetMutexProfileFraction does for the mutex profile.
+func setBlockProfileRate(rate int) int {
+	blockProfileRateMtx.Lock()
+	defer blockProfileRateMtx.Unlock()
+	prev := currentBlockProfileRate
+	runtime.SetBlockProfileRate(rate)
+	currentBlockProfileRate = rate
+	return prev
+}
+
+func captureCPUProfile(buf *limitedBuffer, duration time.Duration) error {
+	// fails if a CPU profile is already running, e.g. one started with
+	// unsafe_start_cpu_profiler
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		return err
+	}
+	time.Sleep(duration)
+	pprof.StopCPUProfile()
+	return buf.err
+}
+
+// limitedBuffer is a bytes.Buffer which refuses writes beyond limit bytes.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+	err   error
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if b.Len()+len(p) > b.limit {
+		b.err = fmt.Errorf("profile exceeds maximum size of %d bytes", b.limit)
+		return 0, b.err
+	}
+	return b.Buffer.Write(p)
+}
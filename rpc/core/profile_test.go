@@ -0,0 +1,51 @@
+package core
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	for _, name := range []string{"cpu", "heap", "goroutine", "mutex", "block"} {
+		res, err := Profile(name, 1)
+		require.NoError(t, err, name)
+		assert.Equal(t, name, res.Name)
+
+		// profiles are gzip compressed protobufs
+		gz, err := gzip.NewReader(bytes.NewReader(res.Data))
+		require.NoError(t, err, name)
+		_, err = ioutil.ReadAll(gz)
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestProfileRestoresBlockProfileRate(t *testing.T) {
+	setBlockProfileRate(5)
+	defer setBlockProfileRate(0)
+
+	_, err := Profile("block", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 5, setBlockProfileRate(5))
+}
+
+func TestProfileInvalid(t *testing.T) {
+	_, err := Profile("unknown", 1)
+	assert.Error(t, err)
+
+	_, err = Profile("cpu", int(maxProfileDuration.Seconds())+1)
+	assert.Error(t, err)
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	buf := &limitedBuffer{limit: 4}
+	_, err := buf.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	_, err = buf.Write([]byte{4, 5})
+	assert.Error(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, buf.Bytes())
+}
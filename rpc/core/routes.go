@@ -16,6 +16,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"health":               rpc.NewRPCFunc(Health, ""),
 	"status":               rpc.NewRPCFunc(Status, ""),
 	"net_info":             rpc.NewRPCFunc(NetInfo, ""),
+	"crawl_report":         rpc.NewRPCFunc(CrawlReport, ""),
 	"blockchain":           rpc.NewRPCFunc(BlockchainInfo, "minHeight,maxHeight"),
 	"genesis":              rpc.NewRPCFunc(Genesis, ""),
 	"block":                rpc.NewRPCFunc(Block, "height"),
@@ -45,9 +46,28 @@ func AddUnsafeRoutes() {
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["unsafe_set_halt"] = rpc.NewRPCFunc(UnsafeSetHalt, "height,time")
+
+	// peer management API
+	Routes["unsafe_disconnect_peer"] = rpc.NewRPCFunc(UnsafeDisconnectPeer, "id,reason")
+	Routes["unsafe_add_persistent_peers"] = rpc.NewRPCFunc(UnsafeAddPersistentPeers, "peers")
+	Routes["unsafe_remove_persistent_peers"] = rpc.NewRPCFunc(UnsafeRemovePersistentPeers, "ids")
+	Routes["unsafe_add_unconditional_peers"] = rpc.NewRPCFunc(UnsafeAddUnconditionalPeers, "ids")
+	Routes["unsafe_remove_unconditional_peers"] = rpc.NewRPCFunc(UnsafeRemoveUnconditionalPeers, "ids")
+	Routes["unsafe_set_peer_pex"] = rpc.NewRPCFunc(UnsafeSetPeerPEX, "id,enabled")
+
+	// ban list API
+	Routes["unsafe_bans"] = rpc.NewRPCFunc(UnsafeBans, "")
+	Routes["unsafe_ban_peer"] = rpc.NewRPCFunc(UnsafeBanPeer, "id,seconds,reason")
+	Routes["unsafe_unban_peer"] = rpc.NewRPCFunc(UnsafeUnbanPeer, "id")
 
 	// profiler API
 	Routes["unsafe_start_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename")
 	Routes["unsafe_stop_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStopCPUProfiler, "")
 	Routes["unsafe_write_heap_profile"] = rpc.NewRPCFunc(UnsafeWriteHeapProfile, "filename")
 }
+
+// AddProfilingRoutes adds the routes capturing runtime profiles of the node.
+func AddProfilingRoutes() {
+	Routes["profile"] = rpc.NewRPCFunc(Profile, "name,seconds")
+}
@@ -10,6 +10,7 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
 	"github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
@@ -90,10 +91,12 @@ func (s *ResultStatus) TxIndexEnabled() bool {
 
 // Info about peer connections
 type ResultNetInfo struct {
-	Listening bool     `json:"listening"`
-	Listeners []string `json:"listeners"`
-	NPeers    int      `json:"n_peers"`
-	Peers     []Peer   `json:"peers"`
+	Listening            bool     `json:"listening"`
+	Listeners            []string `json:"listeners"`
+	NPeers               int      `json:"n_peers"`
+	Peers                []Peer   `json:"peers"`
+	PersistentPeers      []string `json:"persistent_peers"`
+	UnconditionalPeerIDs []string `json:"unconditional_peer_ids"`
 }
 
 // Log from dialing seeds
@@ -106,10 +109,55 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Bans in the address book
+type ResultBans struct {
+	Bans []pex.Ban `json:"bans"`
+}
+
+// Log from banning a peer
+type ResultBanPeer struct {
+	Log string `json:"log"`
+}
+
+// Log from unbanning a peer
+type ResultUnbanPeer struct {
+	Log string `json:"log"`
+}
+
+// Halt height and time the node stops at
+type ResultSetHalt struct {
+	HaltHeight int64     `json:"halt_height"`
+	HaltTime   time.Time `json:"halt_time"`
+}
+
+// Addresses discovered by a seed node's crawler, most reachable first
+type ResultCrawlReport struct {
+	Addresses []CrawledAddress `json:"addresses"`
+}
+
+// Reachability history of an address discovered by the crawler
+type CrawledAddress struct {
+	Addr        *p2p.NetAddress    `json:"addr"`
+	NodeInfo    *pex.CrawlNodeInfo `json:"node_info,omitempty"`
+	Attempts    []pex.CrawlAttempt `json:"attempts"`
+	Successes   int                `json:"successes"`
+	Latency     time.Duration      `json:"latency"`
+	LastAttempt time.Time          `json:"last_attempt"`
+	LastSuccess time.Time          `json:"last_success"`
+}
+
+// Log from managing peers at runtime
+type ResultManagePeers struct {
+	Log string `json:"log"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
+	IsPersistent     bool                 `json:"is_persistent"`
+	IsUnconditional  bool                 `json:"is_unconditional"`
+	PEXEnabled       bool                 `json:"pex_enabled"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         net.IP               `json:"remote_ip"`
 }
@@ -193,6 +241,13 @@ type ResultABCIQuery struct {
 	Response abci.ResponseQuery `json:"response"`
 }
 
+// Runtime profile of the node, in pprof's protobuf format
+type ResultProfile struct {
+	Name    string `json:"name"`
+	Seconds int    `json:"seconds"`
+	Data    []byte `json:"data"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
 )
 
 //-----------------------------------------------------------------------------
@@ -37,6 +38,12 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// decides when to stop committing blocks
+	halter *Halter
+
+	// needed to verify light client attack evidence
+	blockStore BlockStoreRPC
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -47,18 +54,29 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithHalter sets the Halter shared by the consensus and
+// blockchain reactors. By default, the node never halts.
+func BlockExecutorWithHalter(halter *Halter) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.halter = halter
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
-// Call SetEventBus to provide one.
+// Call SetEventBus to provide one. Light client attack evidence is verified
+// against the blockStore.
 func NewBlockExecutor(db dbm.DB, logger log.Logger, proxyApp proxy.AppConnConsensus,
-	mempool Mempool, evpool EvidencePool, options ...BlockExecutorOption) *BlockExecutor {
+	mempool Mempool, evpool EvidencePool, blockStore BlockStoreRPC, options ...BlockExecutorOption) *BlockExecutor {
 	res := &BlockExecutor{
-		db:       db,
-		proxyApp: proxyApp,
-		eventBus: types.NopEventBus{},
-		mempool:  mempool,
-		evpool:   evpool,
-		logger:   logger,
-		metrics:  NopMetrics(),
+		db:         db,
+		proxyApp:   proxyApp,
+		eventBus:   types.NopEventBus{},
+		mempool:    mempool,
+		evpool:     evpool,
+		logger:     logger,
+		metrics:    NopMetrics(),
+		halter:     NewHalter(0, time.Time{}),
+		blockStore: blockStore,
 	}
 
 	for _, option := range options {
@@ -74,29 +92,67 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 	blockExec.eventBus = eventBus
 }
 
+// Halter returns the Halter deciding when the node stops committing blocks.
+func (blockExec *BlockExecutor) Halter() *Halter {
+	return blockExec.halter
+}
+
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
-// Up to 1/10th of the block space is allcoated for maximum sized evidence.
+// Up to 1/10th of the block space is allcoated for maximum sized evidence,
+// light client attack evidence taking its actual size.
 // The rest is given to txs, up to the max gas.
+// The commit may carry vote extensions, which are passed to the app
+// but stripped from the block.
+// The reaped txs are passed to the app with PrepareProposal, which returns the
+// txs of the block. It's an error if they don't fit in the space left for txs
+// (accounting for their amino overhead, like the mempool does).
+// With proposer-based timestamps, the block time is now, the local time of the
+// proposer (see MakeBlock).
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
 	state State, commit *types.Commit,
 	proposerAddr []byte,
-) (*types.Block, *types.PartSet) {
+	now time.Time,
+) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.BlockSize.MaxBytes
 	maxGas := state.ConsensusParams.BlockSize.MaxGas
 
 	// Fetch a limited amount of valid evidence
-	maxNumEvidence, _ := types.MaxEvidencePerBlock(maxBytes)
-	evidence := blockExec.evpool.PendingEvidence(maxNumEvidence)
+	maxNumEvidence, maxEvidenceBytes := types.MaxEvidencePerBlock(maxBytes)
+	evidence := types.EvidenceList(blockExec.evpool.PendingEvidence(maxNumEvidence))
+	for evidence.ByteSize() > maxEvidenceBytes {
+		evidence = evidence[:len(evidence)-1]
+	}
 
 	// Fetch a limited amount of valid txs
-	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
+	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), evidence.ByteSize())
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	// Let the app reorder, drop or add txs
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		Txs:             txs.ToSliceOfBytes(),
+		MaxTxBytes:      maxDataBytes,
+		Height:          height,
+		LocalLastCommit: getExtendedCommitInfo(commit, state.LastValidators),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	txs = types.ToTxs(res.Txs)
+
+	var txBytes int64
+	for _, tx := range txs {
+		txBytes += int64(len(tx)) + types.ComputeAminoOverhead(tx, 1)
+	}
+	if txBytes > maxDataBytes {
+		return nil, nil, fmt.Errorf("PrepareProposal returned txs of %d bytes, exceeding max tx bytes %d",
+			txBytes, maxDataBytes)
+	}
 
+	block, parts := state.MakeBlock(height, txs, commit.StripExtensions(), evidence, proposerAddr, now)
+	return block, parts, nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -104,7 +160,51 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
 func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) error {
-	return validateBlock(blockExec.db, state, block)
+	return validateBlock(blockExec.db, blockExec.blockStore, state, block)
+}
+
+// ProcessProposal asks the app whether to accept the given proposal block,
+// which should already have been validated with ValidateBlock.
+// It returns an error if the app couldn't be reached.
+func (blockExec *BlockExecutor) ProcessProposal(block *types.Block) (bool, error) {
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Hash:   block.Hash(),
+		Header: types.TM2PB.Header(&block.Header),
+		Txs:    block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Accept, nil
+}
+
+// ExtendVote asks the app for the extension of this node's precommit
+// for the given block.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the app whether to accept the extension of
+// another validator's precommit, whose signature should already have been verified.
+// It returns an error if the app couldn't be reached.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) (bool, error) {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Accept, nil
 }
 
 // ApplyBlock validates the block against the state, executes it against the app,
@@ -299,6 +399,31 @@ func execBlockOnProxyApp(
 	return abciResponses, nil
 }
 
+// getExtendedCommitInfo returns the vote info of the given commit for the
+// last validator set, along with the vote extensions.
+func getExtendedCommitInfo(commit *types.Commit, lastValSet *types.ValidatorSet) abci.ExtendedCommitInfo {
+	voteInfos := make([]abci.ExtendedVoteInfo, len(lastValSet.Validators))
+	for i, val := range lastValSet.Validators {
+		var vote *types.Vote
+		if i < len(commit.Precommits) {
+			vote = commit.Precommits[i]
+		}
+		voteInfo := abci.ExtendedVoteInfo{
+			Validator:       types.TM2PB.Validator(val),
+			SignedLastBlock: vote != nil,
+		}
+		if vote != nil {
+			voteInfo.VoteExtension = vote.Extension
+		}
+		voteInfos[i] = voteInfo
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: int32(commit.Round()),
+		Votes: voteInfos,
+	}
+}
+
 func getBeginBlockValidatorInfo(block *types.Block, lastValSet *types.ValidatorSet, stateDB dbm.DB) (abci.LastCommitInfo, []abci.Evidence) {
 
 	// Sanity check that commit length matches validator set size -
@@ -474,8 +599,15 @@ func updateState(
 		lastHeightParamsChanged = header.Height + 1
 	}
 
-	// TODO: allow app to upgrade version
+	// Update the app version, which the blocks carry from the next height.
 	nextVersion := state.Version
+	if appVersion := abciResponses.EndBlock.AppVersion; appVersion != 0 {
+		if appVersion < nextVersion.Consensus.App.Uint64() {
+			return state, fmt.Errorf("Error updating app version: can't go from %v down to %v",
+				nextVersion.Consensus.App, appVersion)
+		}
+		nextVersion.Consensus.App = version.Protocol(appVersion)
+	}
 
 	// NOTE: the AppHash has not been populated.
 	// It will be filled on state.Save.
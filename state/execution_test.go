@@ -38,7 +38,7 @@ func TestApplyBlock(t *testing.T) {
 	state, stateDB := state(1, 1)
 
 	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
-		MockMempool{}, MockEvidencePool{})
+		MockMempool{}, MockEvidencePool{}, nil)
 
 	block := makeBlock(state, 1)
 	blockID := types.BlockID{block.Hash(), block.MakePartSet(testPartSize).Header()}
@@ -82,7 +82,7 @@ func TestBeginBlockValidators(t *testing.T) {
 		lastCommit := &types.Commit{BlockID: prevBlockID, Precommits: tc.lastCommitPrecommits}
 
 		// block for height 2
-		block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address)
+		block, _ := state.MakeBlock(2, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address, tmtime.Now())
 
 		_, err = ExecCommitBlock(proxyApp.Consensus(), block, log.TestingLogger(), state.Validators, stateDB)
 		require.Nil(t, err, tc.desc)
@@ -142,7 +142,7 @@ func TestBeginBlockByzantineValidators(t *testing.T) {
 	lastCommit := &types.Commit{BlockID: prevBlockID, Precommits: votes}
 	for _, tc := range testCases {
 
-		block, _ := state.MakeBlock(10, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address)
+		block, _ := state.MakeBlock(10, makeTxs(2), lastCommit, nil, state.Validators.GetProposer().Address, tmtime.Now())
 		block.Time = now
 		block.Evidence.Evidence = tc.evidence
 		_, err = ExecCommitBlock(proxyApp.Consensus(), block, log.TestingLogger(), state.Validators, stateDB)
@@ -310,7 +310,7 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	state, stateDB := state(1, 1)
 
 	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
-		MockMempool{}, MockEvidencePool{})
+		MockMempool{}, MockEvidencePool{}, nil)
 	eventBus := types.NewEventBus()
 	err = eventBus.Start()
 	require.NoError(t, err)
@@ -354,6 +354,111 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	}
 }
 
+// TestCreateProposalBlockPrepareProposal ensures the block is made of the txs
+// returned by PrepareProposal, as long as they fit.
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB := state(1, 1)
+	state.ConsensusParams.BlockSize.MaxBytes = 4096
+	proposerAddr := state.Validators.GetProposer().Address
+
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		MockMempool{}, MockEvidencePool{}, nil)
+
+	app.PreparedTxs = [][]byte{[]byte("a"), []byte("b")}
+	block, _, err := blockExec.CreateProposalBlock(1, state, new(types.Commit), proposerAddr, tmtime.Now())
+	require.NoError(t, err)
+	assert.Equal(t, types.ToTxs(app.PreparedTxs), block.Data.Txs)
+
+	app.PreparedTxs = [][]byte{cmn.RandBytes(4096)}
+	_, _, err = blockExec.CreateProposalBlock(1, state, new(types.Commit), proposerAddr, tmtime.Now())
+	assert.Error(t, err)
+}
+
+// TestCreateProposalBlockProposerTime ensures that with proposer-based
+// timestamps, the proposal block is timed with the given time, unless it's
+// before the genesis time.
+func TestCreateProposalBlockProposerTime(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB := state(1, 1)
+	state.ConsensusParams.Timestamp = types.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  time.Second,
+	}
+	proposerAddr := state.Validators.GetProposer().Address
+
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		MockMempool{}, MockEvidencePool{}, nil)
+
+	now := state.LastBlockTime.Add(time.Hour)
+	block, _, err := blockExec.CreateProposalBlock(1, state, new(types.Commit), proposerAddr, now)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(block.Time))
+
+	block, _, err = blockExec.CreateProposalBlock(1, state, new(types.Commit), proposerAddr,
+		state.LastBlockTime.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, state.LastBlockTime.Equal(block.Time))
+}
+
+// TestCreateProposalBlockVoteExtensions ensures the vote extensions of the
+// last commit are passed to PrepareProposal, but aren't included in the block.
+func TestCreateProposalBlockVoteExtensions(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop()
+
+	state, stateDB := state(2, 2)
+	proposerAddr := state.Validators.GetProposer().Address
+
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), proxyApp.Consensus(),
+		MockMempool{}, MockEvidencePool{}, nil)
+
+	now := tmtime.Now()
+	vote0 := &types.Vote{ValidatorIndex: 0, Timestamp: now, Type: types.PrecommitType,
+		Extension: []byte("extension"), ExtensionSignature: []byte("signature")}
+	lastCommit := &types.Commit{
+		BlockID:    types.BlockID{Hash: state.LastBlockID.Hash},
+		Precommits: []*types.Vote{vote0, nil},
+	}
+
+	block, _, err := blockExec.CreateProposalBlock(2, state, lastCommit, proposerAddr, tmtime.Now())
+	require.NoError(t, err)
+
+	votes := app.LocalLastCommit.Votes
+	require.Len(t, votes, 2)
+	for i, val := range state.LastValidators.Validators {
+		assert.EqualValues(t, val.Address, votes[i].Validator.Address)
+	}
+	assert.True(t, votes[0].SignedLastBlock)
+	assert.Equal(t, []byte("extension"), votes[0].VoteExtension)
+	assert.False(t, votes[1].SignedLastBlock)
+	assert.Empty(t, votes[1].VoteExtension)
+
+	require.NotNil(t, block.LastCommit.Precommits[0])
+	assert.Empty(t, block.LastCommit.Precommits[0].Extension)
+	assert.Empty(t, block.LastCommit.Precommits[0].ExtensionSignature)
+	assert.Nil(t, block.LastCommit.Precommits[1])
+	// the commit passed in is left untouched
+	assert.Equal(t, []byte("extension"), vote0.Extension)
+}
+
 //----------------------------------------------------------------------------
 
 // make some bogus txs
@@ -395,7 +500,7 @@ func state(nVals, height int) (State, dbm.DB) {
 }
 
 func makeBlock(state State, height int64) *types.Block {
-	block, _ := state.MakeBlock(height, makeTxs(state.LastBlockHeight), new(types.Commit), nil, state.Validators.GetProposer().Address)
+	block, _ := state.MakeBlock(height, makeTxs(state.LastBlockHeight), new(types.Commit), nil, state.Validators.GetProposer().Address, tmtime.Now())
 	return block
 }
 
@@ -407,6 +512,8 @@ type testApp struct {
 	CommitVotes         []abci.VoteInfo
 	ByzantineValidators []abci.Evidence
 	ValidatorUpdates    []abci.ValidatorUpdate
+	PreparedTxs         [][]byte
+	LocalLastCommit     abci.ExtendedCommitInfo
 }
 
 var _ abci.Application = (*testApp)(nil)
@@ -415,6 +522,11 @@ func (app *testApp) Info(req abci.RequestInfo) (resInfo abci.ResponseInfo) {
 	return abci.ResponseInfo{}
 }
 
+func (app *testApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.LocalLastCommit = req.LocalLastCommit
+	return abci.ResponsePrepareProposal{Txs: app.PreparedTxs}
+}
+
 func (app *testApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	app.CommitVotes = req.LastCommitInfo.Votes
 	app.ByzantineValidators = req.ByzantineValidators
@@ -429,7 +541,7 @@ func (app *testApp) DeliverTx(tx []byte) abci.ResponseDeliverTx {
 	return abci.ResponseDeliverTx{Tags: []cmn.KVPair{}}
 }
 
-func (app *testApp) CheckTx(tx []byte) abci.ResponseCheckTx {
+func (app *testApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	return abci.ResponseCheckTx{}
 }
 
@@ -0,0 +1,75 @@
+package state
+
+import (
+	"sync"
+	"time"
+)
+
+// Halter decides when the node stops committing blocks, so that operators can
+// coordinate a chain upgrade. The node halts after committing the first block
+// whose height reaches the halt height or whose time reaches the halt time.
+// A zero height or time disables the corresponding condition.
+// It is safe for concurrent use.
+type Halter struct {
+	mtx    sync.Mutex
+	height int64
+	time   time.Time
+
+	once   sync.Once
+	halted chan struct{}
+}
+
+// NewHalter returns a Halter for the given height and time.
+func NewHalter(height int64, t time.Time) *Halter {
+	return &Halter{
+		height: height,
+		time:   t,
+		halted: make(chan struct{}),
+	}
+}
+
+// SetHalt changes the halt height and time. Zero values disable them.
+func (h *Halter) SetHalt(height int64, t time.Time) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.height = height
+	h.time = t
+}
+
+// HaltHeight returns the halt height, or 0 if unset.
+func (h *Halter) HaltHeight() int64 {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return h.height
+}
+
+// HaltTime returns the halt time, or the zero time if unset.
+func (h *Halter) HaltTime() time.Time {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return h.time
+}
+
+// ShouldHalt returns true if the node must stop after committing the block
+// with the given height and time. Height 0 means no block was committed yet.
+func (h *Halter) ShouldHalt(height int64, blockTime time.Time) bool {
+	if height <= 0 {
+		return false
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if h.height > 0 && height >= h.height {
+		return true
+	}
+	return !h.time.IsZero() && !blockTime.Before(h.time)
+}
+
+// Halt marks the node as halted. It's safe to call it multiple times.
+func (h *Halter) Halt() {
+	h.once.Do(func() { close(h.halted) })
+}
+
+// Halted returns a channel that's closed once the node halted.
+func (h *Halter) Halted() <-chan struct{} {
+	return h.halted
+}
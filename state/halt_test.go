@@ -0,0 +1,45 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHalterShouldHalt(t *testing.T) {
+	now := time.Now()
+
+	h := NewHalter(0, time.Time{})
+	assert.False(t, h.ShouldHalt(100, now))
+
+	h.SetHalt(10, time.Time{})
+	assert.False(t, h.ShouldHalt(9, now))
+	assert.True(t, h.ShouldHalt(10, now))
+	assert.True(t, h.ShouldHalt(11, now))
+
+	h.SetHalt(0, now)
+	assert.False(t, h.ShouldHalt(1, now.Add(-time.Second)))
+	assert.True(t, h.ShouldHalt(1, now))
+	assert.True(t, h.ShouldHalt(1, now.Add(time.Second)))
+
+	// nothing was committed yet at height 0
+	assert.False(t, h.ShouldHalt(0, now))
+}
+
+func TestHalterHalt(t *testing.T) {
+	h := NewHalter(1, time.Time{})
+	select {
+	case <-h.Halted():
+		t.Fatal("halted before calling Halt")
+	default:
+	}
+
+	h.Halt()
+	h.Halt() // no panic on multiple calls
+	select {
+	case <-h.Halted():
+	default:
+		t.Fatal("not halted after calling Halt")
+	}
+}
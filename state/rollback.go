@@ -0,0 +1,106 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Rollback overwrites the current Tendermint state (height n) with the most
+// recent previous state (height n - 1), using the validator sets, consensus
+// params and ABCI responses persisted for each height.
+// The block store is left untouched, so that on restart the node re-executes
+// block n against the application.
+// It returns the height and app hash of the state that was rolled back to.
+func Rollback(blockStore BlockStore, stateDB dbm.DB) (int64, []byte, error) {
+	invalidState := LoadState(stateDB)
+	if invalidState.IsEmpty() {
+		return -1, nil, errors.New("no state found")
+	}
+
+	height := blockStore.Height()
+
+	// NOTE: persistence of state and blocks doesn't happen atomically. If the
+	// node stopped after the block was saved but before the state was, the
+	// state is already one height behind the block store and there is
+	// nothing to roll back.
+	if height == invalidState.LastBlockHeight+1 {
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+
+	// Otherwise the state must be at the same height as the block store.
+	if height != invalidState.LastBlockHeight {
+		return -1, nil, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, height)
+	}
+
+	rollbackHeight := invalidState.LastBlockHeight - 1
+	rollbackBlock := blockStore.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+	// The app hash resulting from executing the rollback height is only
+	// recorded in the header of the following block.
+	latestBlock := blockStore.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousLastValidatorSet, err := LoadValidators(stateDB, rollbackHeight)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	previousParams, err := LoadConsensusParams(stateDB, rollbackHeight+1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	abciResponses, err := LoadABCIResponses(stateDB, rollbackHeight)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	// The heights at which the validators and consensus params last changed,
+	// as seen by the rolled back state, were persisted when it was saved.
+	valInfo := loadValidatorsInfo(stateDB, rollbackHeight+2)
+	if valInfo == nil {
+		return -1, nil, ErrNoValSetForHeight{rollbackHeight + 2}
+	}
+
+	paramsInfo := loadConsensusParamsInfo(stateDB, rollbackHeight+1)
+	if paramsInfo == nil {
+		return -1, nil, ErrNoConsensusParamsForHeight{rollbackHeight + 1}
+	}
+
+	// build the new state from the old state and the prior block
+	rolledBackState := State{
+		Version: Version{
+			Consensus: latestBlock.Header.Version,
+			Software:  invalidState.Version.Software,
+		},
+		// immutable fields
+		ChainID: invalidState.ChainID,
+
+		LastBlockHeight:  rollbackBlock.Header.Height,
+		LastBlockTotalTx: rollbackBlock.Header.TotalTxs,
+		LastBlockID:      rollbackBlock.BlockID,
+		LastBlockTime:    rollbackBlock.Header.Time,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  invalidState.LastValidators,
+		LastValidators:              previousLastValidatorSet,
+		LastHeightValidatorsChanged: valInfo.LastHeightChanged,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsInfo.LastHeightChanged,
+
+		LastResultsHash: abciResponses.ResultsHash(),
+		AppHash:         latestBlock.Header.AppHash,
+	}
+
+	SaveState(stateDB, rolledBackState)
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
@@ -0,0 +1,128 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRollback(t *testing.T) {
+	s, stateDB := state(1, 1)
+	blockStore := newMockBlockStore()
+
+	states := make(map[int64]State)
+	states[0] = s
+
+	// commit 3 heights, changing the consensus params at height 2
+	for height := int64(1); height <= 3; height++ {
+		header := types.Header{
+			Version:         s.Version.Consensus,
+			ChainID:         s.ChainID,
+			Height:          height,
+			Time:            tmtime.Now(),
+			NumTxs:          int64(nTxsPerBlock),
+			TotalTxs:        s.LastBlockTotalTx + int64(nTxsPerBlock),
+			AppHash:         s.AppHash,
+			LastResultsHash: s.LastResultsHash,
+		}
+		blockID := types.BlockID{Hash: cmn.RandBytes(20)}
+		blockStore.metas[height] = &types.BlockMeta{BlockID: blockID, Header: header}
+
+		abciResponses := &ABCIResponses{
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		}
+		for i := 0; i < nTxsPerBlock; i++ {
+			abciResponses.DeliverTx = append(abciResponses.DeliverTx,
+				&abci.ResponseDeliverTx{Code: abci.CodeTypeOK, Data: []byte{byte(height), byte(i)}})
+		}
+		if height == 2 {
+			abciResponses.EndBlock.ConsensusParamUpdates = &abci.ConsensusParams{
+				BlockSize: &abci.BlockSizeParams{MaxBytes: 1000, MaxGas: -1},
+			}
+		}
+		saveABCIResponses(stateDB, height, abciResponses)
+
+		var err error
+		s, err = updateState(s, blockID, &header, abciResponses, nil)
+		require.NoError(t, err)
+		s.AppHash = []byte{byte(height)}
+		SaveState(stateDB, s)
+		states[height] = s
+	}
+
+	rollbackHeight, rollbackHash, err := Rollback(blockStore, stateDB)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, rollbackHeight)
+	assert.EqualValues(t, states[2].AppHash, rollbackHash)
+
+	loadedState := LoadState(stateDB)
+	assert.True(t, states[2].Equals(loadedState),
+		"expected rolled back state to equal the state at height 2.\ngot: %v\nexpected: %v\n",
+		loadedState, states[2])
+
+	// the stored validators and params must still be consistent
+	params, err := LoadConsensusParams(stateDB, 3)
+	require.NoError(t, err)
+	assert.Equal(t, states[2].ConsensusParams, params)
+}
+
+func TestRollbackNoState(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	_, _, err := Rollback(newMockBlockStore(), stateDB)
+	assert.Error(t, err)
+}
+
+func TestRollbackDifferentStateHeight(t *testing.T) {
+	s, stateDB := state(1, 1)
+	s.LastBlockHeight = 10
+	SaveState(stateDB, s)
+
+	blockStore := newMockBlockStore()
+	blockStore.height = 12
+
+	_, _, err := Rollback(blockStore, stateDB)
+	assert.Error(t, err)
+
+	// the block store is only one height ahead, so there is nothing to do
+	blockStore.height = 11
+	height, _, err := Rollback(blockStore, stateDB)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, height)
+}
+
+//----------------------------------------------------------------------------
+
+type mockBlockStore struct {
+	height  int64
+	metas   map[int64]*types.BlockMeta
+	commits map[int64]*types.Commit
+}
+
+var _ BlockStore = (*mockBlockStore)(nil)
+
+func newMockBlockStore() *mockBlockStore {
+	return &mockBlockStore{metas: make(map[int64]*types.BlockMeta)}
+}
+
+func (bs *mockBlockStore) Height() int64 {
+	if bs.height != 0 {
+		return bs.height
+	}
+	return int64(len(bs.metas))
+}
+func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta       { return bs.metas[height] }
+func (bs *mockBlockStore) LoadBlock(height int64) *types.Block               { return nil }
+func (bs *mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
+func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { return bs.commits[height] }
+func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
+func (bs *mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+}
@@ -130,12 +130,15 @@ func (state State) IsEmpty() bool {
 // MakeBlock builds a block from the current state with the given txs, commit,
 // and evidence. Note it also takes a proposerAddress because the state does not
 // track rounds, and hence does not know the correct proposer. TODO: fix this!
+// With proposer-based timestamps, the block time is taken from now, the local
+// time of the proposer.
 func (state State) MakeBlock(
 	height int64,
 	txs []types.Tx,
 	commit *types.Commit,
 	evidence []types.Evidence,
 	proposerAddress []byte,
+	now time.Time,
 ) (*types.Block, *types.PartSet) {
 
 	// Build base block with block data.
@@ -143,7 +146,9 @@ func (state State) MakeBlock(
 
 	// Set time.
 	var timestamp time.Time
-	if height == 1 {
+	if state.ConsensusParams.Timestamp.ProposerBased {
+		timestamp = proposerTime(height, state.LastBlockTime, now)
+	} else if height == 1 {
 		timestamp = state.LastBlockTime // genesis time
 	} else {
 		timestamp = MedianTime(commit, state.LastValidators)
@@ -161,6 +166,19 @@ func (state State) MakeBlock(
 	return block, block.MakePartSet(types.BlockPartSizeBytes)
 }
 
+// proposerTime returns the time of a block proposed with proposer-based
+// timestamps: the local time, unless it doesn't come after the last block
+// time (the genesis time for the first block), e.g. because of clock drift.
+func proposerTime(height int64, lastBlockTime, now time.Time) time.Time {
+	if height == 1 && now.Before(lastBlockTime) {
+		return lastBlockTime
+	}
+	if height > 1 && !now.After(lastBlockTime) {
+		return lastBlockTime.Add(time.Millisecond)
+	}
+	return now
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
@@ -15,6 +15,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
 
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/types"
@@ -862,6 +863,42 @@ func TestStateMakeBlock(t *testing.T) {
 	assert.Equal(t, proposerAddress, block.ProposerAddress)
 }
 
+// TestUpdateStateAppVersion tests the app can bump the app version in
+// EndBlock, which the next blocks must then carry.
+func TestUpdateStateAppVersion(t *testing.T) {
+	tearDown, stateDB, state := setupTestCase(t)
+	defer tearDown(t)
+
+	oldBlock := makeBlock(state, 1)
+	header, blockID := oldBlock.Header, types.BlockID{oldBlock.Hash(), types.PartSetHeader{}}
+	appVersion := state.Version.Consensus.App.Uint64() + 2
+
+	// 0 keeps the current version
+	responses := &ABCIResponses{EndBlock: &abci.ResponseEndBlock{}}
+	nextState, err := updateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	assert.Equal(t, state.Version, nextState.Version)
+
+	responses.EndBlock.AppVersion = appVersion
+	nextState, err = updateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, appVersion, nextState.Version.Consensus.App)
+	assert.Equal(t, state.Version.Consensus.Block, nextState.Version.Consensus.Block)
+
+	// the next blocks carry the new version, and blocks with the old one are invalid
+	block := makeBlock(nextState, 2)
+	assert.EqualValues(t, appVersion, block.Version.App)
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil, nil)
+	err = blockExec.ValidateBlock(nextState, oldBlock)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Wrong Block.Header.Version")
+
+	// the version can't go down
+	responses.EndBlock.AppVersion = appVersion - 1
+	_, err = updateState(nextState, blockID, &header, responses, nil)
+	assert.Error(t, err)
+}
+
 // TestConsensusParamsChangesSaveLoad tests saving and loading consensus params
 // with changes.
 func TestConsensusParamsChangesSaveLoad(t *testing.T) {
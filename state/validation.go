@@ -13,7 +13,7 @@ import (
 //-----------------------------------------------------
 // Validate block
 
-func validateBlock(stateDB dbm.DB, state State, block *types.Block) error {
+func validateBlock(stateDB dbm.DB, blockStore BlockStoreRPC, state State, block *types.Block) error {
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -99,6 +99,14 @@ func validateBlock(stateDB dbm.DB, state State, block *types.Block) error {
 				len(block.LastCommit.Precommits),
 			)
 		}
+		// Vote extensions are only passed to the proposer's app, never
+		// included in blocks.
+		for _, precommit := range block.LastCommit.Precommits {
+			if precommit != nil && (len(precommit.Extension) > 0 || len(precommit.ExtensionSignature) > 0) {
+				return fmt.Errorf("Invalid block commit: precommit of validator %X has a vote extension",
+					precommit.ValidatorAddress)
+			}
+		}
 		err := state.LastValidators.VerifyCommit(
 			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit)
 		if err != nil {
@@ -115,16 +123,27 @@ func validateBlock(stateDB dbm.DB, state State, block *types.Block) error {
 			)
 		}
 
-		medianTime := MedianTime(block.LastCommit, state.LastValidators)
-		if !block.Time.Equal(medianTime) {
-			return fmt.Errorf("Invalid block time. Expected %v, got %v",
-				medianTime,
-				block.Time,
-			)
+		// With proposer-based timestamps, validators check the block time
+		// against their clock before prevoting instead.
+		if !state.ConsensusParams.Timestamp.ProposerBased {
+			medianTime := MedianTime(block.LastCommit, state.LastValidators)
+			if !block.Time.Equal(medianTime) {
+				return fmt.Errorf("Invalid block time. Expected %v, got %v",
+					medianTime,
+					block.Time,
+				)
+			}
 		}
 	} else if block.Height == 1 {
 		genesisTime := state.LastBlockTime
-		if !block.Time.Equal(genesisTime) {
+		if state.ConsensusParams.Timestamp.ProposerBased {
+			if block.Time.Before(genesisTime) {
+				return fmt.Errorf("Block time %v is before genesis time %v",
+					block.Time,
+					genesisTime,
+				)
+			}
+		} else if !block.Time.Equal(genesisTime) {
 			return fmt.Errorf("Block time %v is not equal to genesis time %v",
 				block.Time,
 				genesisTime,
@@ -133,16 +152,19 @@ func validateBlock(stateDB dbm.DB, state State, block *types.Block) error {
 	}
 
 	// Limit the amount of evidence
-	maxNumEvidence, _ := types.MaxEvidencePerBlock(state.ConsensusParams.BlockSize.MaxBytes)
+	maxNumEvidence, maxEvidenceBytes := types.MaxEvidencePerBlock(state.ConsensusParams.BlockSize.MaxBytes)
 	numEvidence := int64(len(block.Evidence.Evidence))
 	if numEvidence > maxNumEvidence {
 		return types.NewErrEvidenceOverflow(maxNumEvidence, numEvidence)
 
 	}
+	if evidenceBytes := block.Evidence.Evidence.ByteSize(); evidenceBytes > maxEvidenceBytes {
+		return fmt.Errorf("Too much evidence: Max %d bytes, got %d", maxEvidenceBytes, evidenceBytes)
+	}
 
 	// Validate all evidence.
 	for _, ev := range block.Evidence.Evidence {
-		if err := VerifyEvidence(stateDB, state, ev); err != nil {
+		if err := VerifyEvidence(stateDB, blockStore, state, ev); err != nil {
 			return types.NewErrEvidenceInvalid(ev, err)
 		}
 	}
@@ -165,7 +187,8 @@ func validateBlock(stateDB dbm.DB, state State, block *types.Block) error {
 // - it is from a key who was a validator at the given height
 // - it is internally consistent
 // - it was properly signed by the alleged equivocator
-func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error {
+// - for light client attacks, it conflicts with the block in the block store
+func VerifyEvidence(stateDB dbm.DB, blockStore BlockStoreRPC, state State, evidence types.Evidence) error {
 	height := state.LastBlockHeight
 
 	evidenceAge := height - evidence.Height()
@@ -196,5 +219,84 @@ func VerifyEvidence(stateDB dbm.DB, state State, evidence types.Evidence) error
 		return err
 	}
 
+	if ev, ok := evidence.(*types.LightClientAttackEvidence); ok {
+		return verifyLightClientAttack(blockStore, state, valset, ev)
+	}
+
+	return nil
+}
+
+// verifyLightClientAttack checks the conflicting header of the evidence is
+// one a light client trusting the validators at its height would accept:
+//
+// - lunatic: the header commits to other validators than the chain's, and
+// its signers include more than 1/3 of the voting power of the chain's
+// validators.
+// - equivocation: the header commits to the chain's validators, which signed
+// another block than the chain's in the same round. Different rounds may be
+// an amnesia attack, which can't be attributed, so the evidence is rejected.
+//
+// The evidence must already have been verified, and valset must be the
+// validators at its height.
+func verifyLightClientAttack(blockStore BlockStoreRPC, state State,
+	valset *types.ValidatorSet, ev *types.LightClientAttackEvidence) error {
+	height := ev.Height()
+	if blockStore == nil {
+		return errors.New("Can't verify light client attack evidence without a block store")
+	}
+	if height > state.LastBlockHeight {
+		return fmt.Errorf("Light client attack at height %d is from the future. Last height is %d",
+			height, state.LastBlockHeight)
+	}
+
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return fmt.Errorf("No block at height %d to compare the conflicting header with", height)
+	}
+	conflicting := ev.ConflictingHeader
+	if bytes.Equal(conflicting.Hash(), meta.BlockID.Hash) {
+		return fmt.Errorf("Header at height %d doesn't conflict with the block", height)
+	}
+
+	if ev.AttackType(valset) == types.ABCIEvidenceTypeLightClientLunatic {
+		trustedPower := int64(0)
+		for idx, precommit := range conflicting.Commit.Precommits {
+			if precommit == nil || !precommit.BlockID.Equals(conflicting.Commit.BlockID) {
+				continue
+			}
+			_, signer := ev.ConflictingValidators.GetByIndex(idx)
+			_, val := valset.GetByAddress(signer.Address)
+			if val != nil && val.PubKey.Equals(signer.PubKey) {
+				trustedPower += val.VotingPower
+			}
+		}
+		if trustedPower*3 <= valset.TotalVotingPower() {
+			return fmt.Errorf("Conflicting header is signed by %d of %d voting power, which doesn't fool light clients",
+				trustedPower, valset.TotalVotingPower())
+		}
+		return nil
+	}
+
+	// The commit of the last block is only in the seen commit.
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return fmt.Errorf("No commit at height %d to compare the conflicting header with", height)
+	}
+	if commit.Round() != conflicting.Commit.Round() {
+		return fmt.Errorf("Conflicting header is committed in round %d, and the block in round %d",
+			conflicting.Commit.Round(), commit.Round())
+	}
+	idx, _ := valset.GetByAddress(ev.Address())
+	if idx >= len(commit.Precommits) {
+		return fmt.Errorf("Commit at height %d has %d precommits for %d validators",
+			height, len(commit.Precommits), valset.Size())
+	}
+	precommit := commit.GetByIndex(idx)
+	if precommit == nil || !precommit.BlockID.Equals(commit.BlockID) {
+		return fmt.Errorf("Validator %X didn't sign the block at height %d", ev.Address(), height)
+	}
 	return nil
 }
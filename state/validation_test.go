@@ -1,15 +1,19 @@
 package state
 
 import (
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // TODO(#2589):
@@ -20,7 +24,7 @@ func TestValidateBlockHeader(t *testing.T) {
 	var height int64 = 1 // TODO(#2589): generalize
 	state, stateDB := state(1, int(height))
 
-	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil)
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil, nil)
 
 	// A good block passes.
 	block := makeBlock(state, height)
@@ -70,6 +74,28 @@ func TestValidateBlockHeader(t *testing.T) {
 	}
 }
 
+func TestValidateBlockTimeProposerBased(t *testing.T) {
+	var height int64 = 1
+	state, stateDB := state(1, int(height))
+	state.ConsensusParams.Timestamp = types.TimestampParams{
+		ProposerBased: true,
+		Precision:     time.Second,
+		MessageDelay:  time.Second,
+	}
+
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil, nil)
+
+	// The proposer sets the block time from its clock, which may be after
+	// the genesis time, but not before.
+	block := makeBlock(state, height)
+	require.False(t, block.Time.Before(state.LastBlockTime))
+	block.Time = state.LastBlockTime.Add(time.Hour)
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	block.Time = state.LastBlockTime.Add(-time.Second)
+	require.Error(t, blockExec.ValidateBlock(state, block))
+}
+
 /*
 	TODO(#2589):
 	- test Block.Data.Hash() == Block.DataHash
@@ -84,6 +110,32 @@ func TestValidateBlockData(t *testing.T) {
 	- test state.LastValidators.VerifyCommit
 */
 func TestValidateBlockCommit(t *testing.T) {
+	var height int64 = 2
+	state, stateDB := state(1, int(height))
+	privKey := ed25519.GenPrivKeyFromSecret([]byte("test0"))
+	privVal := types.NewMockPVWithPrivKey(privKey)
+	proposer := state.Validators.GetProposer().Address
+	state.LastBlockID = types.BlockID{Hash: tmhash.Sum([]byte("last_block"))}
+
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil, nil)
+
+	// A block with the commit of the last height passes.
+	commit := makeTestCommit(t, state.LastBlockID, height-1, 0, []types.PrivValidator{privVal})
+	block, _ := state.MakeBlock(height, makeTxs(height), commit, nil, proposer, tmtime.Now())
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// A block whose commit carries vote extensions fails, even if they are
+	// properly signed.
+	commit = makeTestCommit(t, state.LastBlockID, height-1, 0, []types.PrivValidator{privVal})
+	precommit := commit.Precommits[0]
+	precommit.Extension = []byte("extension")
+	extSig, err := privKey.Sign(precommit.ExtensionSignBytes(chainID))
+	require.NoError(t, err)
+	precommit.ExtensionSignature = extSig
+	block, _ = state.MakeBlock(height, makeTxs(height), commit, nil, proposer, tmtime.Now())
+	err = blockExec.ValidateBlock(state, block)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vote extension")
 }
 
 /*
@@ -94,7 +146,7 @@ func TestValidateBlockEvidence(t *testing.T) {
 	var height int64 = 1 // TODO(#2589): generalize
 	state, stateDB := state(1, int(height))
 
-	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil)
+	blockExec := NewBlockExecutor(stateDB, log.TestingLogger(), nil, nil, nil, nil)
 
 	// make some evidence
 	addr, _ := state.Validators.GetByIndex(0)
@@ -129,3 +181,102 @@ func TestValidateBlockEvidence(t *testing.T) {
 */
 func TestValidateBlockSize(t *testing.T) {
 }
+
+func TestVerifyLightClientAttackEvidence(t *testing.T) {
+	const height = int64(3)
+	state, stateDB := state(4, 5)
+	privVals := make([]types.PrivValidator, 4)
+	for i := range privVals {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("test%d", i)))
+		privVals[i] = types.NewMockPVWithPrivKey(privKey)
+	}
+	lunatics := []types.PrivValidator{privVals[0], privVals[1], types.NewMockPV(), types.NewMockPV()}
+
+	// the chain committed a block in round 1
+	blockStore := newMockBlockStore()
+	blockID := types.BlockID{Hash: tmhash.Sum([]byte("block"))}
+	blockStore.metas[height] = &types.BlockMeta{BlockID: blockID}
+	blockStore.commits = map[int64]*types.Commit{height: makeTestCommit(t, blockID, height, 1, privVals)}
+
+	testCases := []struct {
+		desc       string
+		round      int
+		signers    []types.PrivValidator
+		malleate   func(ev *types.LightClientAttackEvidence, blockStore *mockBlockStore)
+		blockStore bool
+		expectErr  bool
+	}{
+		{"equivocation", 1, privVals, nil, true, false},
+		{"equivocation in another round", 2, privVals, nil, true, true},
+		{"equivocation without block store", 1, privVals, nil, false, true},
+		{"equivocation not signing the block", 1, privVals, func(_ *types.LightClientAttackEvidence, bs *mockBlockStore) {
+			commit := *bs.commits[height]
+			commit.Precommits = []*types.Vote{nil, nil, nil, nil}
+			bs.commits = map[int64]*types.Commit{height: &commit}
+		}, true, true},
+		{"header of the chain", 1, privVals, func(ev *types.LightClientAttackEvidence, bs *mockBlockStore) {
+			bs.metas = map[int64]*types.BlockMeta{height: {BlockID: ev.ConflictingHeader.Commit.BlockID}}
+		}, true, true},
+		{"lunatic", 0, lunatics, nil, true, false},
+		{"lunatic with 1/3 of the voting power", 0, lunatics[1:], func(ev *types.LightClientAttackEvidence, _ *mockBlockStore) {
+			ev.PubKey = lunatics[1].GetPubKey()
+		}, true, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			bs := &mockBlockStore{metas: blockStore.metas, commits: blockStore.commits}
+			header, vals := makeConflictingHeader(t, height, tc.round, tc.signers)
+			ev := &types.LightClientAttackEvidence{
+				PubKey:                privVals[0].GetPubKey(),
+				ConflictingHeader:     header,
+				ConflictingValidators: vals,
+			}
+			if tc.malleate != nil {
+				tc.malleate(ev, bs)
+			}
+			var store BlockStoreRPC
+			if tc.blockStore {
+				store = bs
+			}
+			err := VerifyEvidence(stateDB, store, state, ev)
+			assert.Equal(t, tc.expectErr, err != nil, "%v", err)
+		})
+	}
+}
+
+// makeConflictingHeader returns a header at the height committing to the
+// validators of the given keys, signed by them all in the round.
+func makeConflictingHeader(t *testing.T, height int64, round int,
+	privVals []types.PrivValidator) (types.SignedHeader, *types.ValidatorSet) {
+	vals := make([]*types.Validator, len(privVals))
+	for i, privVal := range privVals {
+		vals[i] = types.NewValidator(privVal.GetPubKey(), 1000)
+	}
+	valSet := types.NewValidatorSet(vals)
+	header := &types.Header{
+		ChainID:        chainID,
+		Height:         height,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+		AppHash:        []byte("conflicting"),
+	}
+	blockID := types.BlockID{Hash: header.Hash()}
+	commit := makeTestCommit(t, blockID, height, round, privVals)
+	return types.SignedHeader{Header: header, Commit: commit}, valSet
+}
+
+// makeTestCommit returns a commit of the block by the validators of the given
+// keys, all with the same voting power.
+func makeTestCommit(t *testing.T, blockID types.BlockID, height int64, round int,
+	privVals []types.PrivValidator) *types.Commit {
+	privVals = append([]types.PrivValidator(nil), privVals...)
+	sort.Sort(types.PrivValidatorsByAddress(privVals))
+	vals := make([]*types.Validator, len(privVals))
+	for i, privVal := range privVals {
+		vals[i] = types.NewValidator(privVal.GetPubKey(), 1000)
+	}
+	voteSet := types.NewVoteSet(chainID, height, round, types.PrecommitType, types.NewValidatorSet(vals))
+	commit, err := types.MakeCommit(blockID, height, round, voteSet, privVals)
+	require.NoError(t, err)
+	return commit
+}
@@ -0,0 +1,75 @@
+// +build byzantine
+
+// Command node is the tendermint CLI with an additional --misbehaviors flag
+// for the node command, which makes the validator misbehave at the given
+// heights. It is used by the end-to-end test runner to run byzantine
+// validators, and must never be used outside of tests.
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	cmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	cfg "github.com/tendermint/tendermint/config"
+	cs "github.com/tendermint/tendermint/consensus"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	nm "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+// misbehaviors is the value of the --misbehaviors flag.
+var misbehaviors string
+
+func main() {
+	rootCmd := cmd.RootCmd
+	rootCmd.AddCommand(
+		cmd.GenValidatorCmd,
+		cmd.InitFilesCmd,
+		cmd.ResetAllCmd,
+		cmd.ResetPrivValidatorCmd,
+		cmd.ShowValidatorCmd,
+		cmd.TestnetFilesCmd,
+		cmd.ShowNodeIDCmd,
+		cmd.GenNodeKeyCmd,
+		cmd.VersionCmd,
+	)
+
+	nodeCmd := cmd.NewRunNodeCmd(newNode)
+	nodeCmd.Flags().StringVar(&misbehaviors, "misbehaviors", "",
+		"Comma-delimited height=misbehavior pairs, eg. 10=double-prevote (height 0 for every height)")
+	rootCmd.AddCommand(nodeCmd)
+
+	cmd := cli.PrepareBaseCmd(rootCmd, "TM", os.ExpandEnv(filepath.Join("$HOME", cfg.DefaultTendermintDir)))
+	if err := cmd.Execute(); err != nil {
+		panic(err)
+	}
+}
+
+// newNode creates a node, which misbehaves if --misbehaviors is set.
+func newNode(config *cfg.Config, logger log.Logger) (*nm.Node, error) {
+	heights, err := cs.ParseMisbehaviors(misbehaviors)
+	if err != nil {
+		return nil, err
+	}
+	n, err := nm.DefaultNewNode(config, logger)
+	if err != nil || len(heights) == 0 {
+		return n, err
+	}
+	if config.PrivValidatorListenAddr != "" {
+		return nil, errors.New("misbehaving validators must use a local private validator")
+	}
+
+	// sign with the validator key, without the double signing protection
+	filePV := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	privVal := types.NewMockPVWithPrivKey(filePV.Key.PrivKey)
+	_, err = cs.NewByzantineValidator(n.ConsensusState(), privVal, n.ConsensusReactor().ByzantineNetwork(), heights)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Validator will misbehave", "misbehaviors", misbehaviors)
+	return n, nil
+}
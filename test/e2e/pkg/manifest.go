@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// Manifest represents a TOML testnet manifest.
+type Manifest struct {
+	// App is the built-in ABCI application run by every node, either
+	// "kvstore" or "persistent_kvstore". Defaults to "persistent_kvstore",
+	// which keeps its state across restarts.
+	App string `mapstructure:"app"`
+
+	// LoadTxRate is the number of transactions per second broadcast to the
+	// testnet while it is running. 0 disables transaction load.
+	LoadTxRate int `mapstructure:"load_tx_rate"`
+
+	// Nodes specifies the network nodes, keyed by name. At least one node
+	// must be given.
+	Nodes map[string]ManifestNode `mapstructure:"node"`
+}
+
+// ManifestNode represents a node in a testnet manifest.
+type ManifestNode struct {
+	// Mode specifies the type of node: "validator", "full" or "seed".
+	// Defaults to "validator". Full nodes and seeds do not get a validator
+	// key in the genesis file.
+	Mode string `mapstructure:"mode"`
+
+	// Seeds is the list of node names to use as P2P seed nodes. Defaults to
+	// none.
+	Seeds []string `mapstructure:"seeds"`
+
+	// PersistentPeers is a list of node names to maintain persistent P2P
+	// connections to. If neither seeds nor persistent peers are specified,
+	// this defaults to all other nodes in the network that start at the
+	// initial height.
+	PersistentPeers []string `mapstructure:"persistent_peers"`
+
+	// StartAt specifies the block height at which the node will be started.
+	// The runner will wait for the network to reach at least this block
+	// height before starting the node. Defaults to 0, i.e. starting with the
+	// rest of the network.
+	StartAt int64 `mapstructure:"start_at"`
+
+	// FastSync enables fast sync, which is useful for nodes joining the
+	// network late. Defaults to false.
+	FastSync bool `mapstructure:"fast_sync"`
+
+	// Perturb lists perturbations to apply to the node after it has been
+	// started and synced with the network:
+	//
+	// disconnect: suspends the node process (SIGSTOP) long enough for its
+	//             peers' ping/pong timeouts to drop the connection, then
+	//             resumes it. Local processes share the loopback interface, so
+	//             this is the closest to a network partition the runner gets.
+	//
+	// kill: kills the node with SIGKILL then restarts it.
+	//
+	// pause: suspends the node process (SIGSTOP) for 10 seconds, then
+	//        resumes it. This is too short for peers to drop the connection.
+	//
+	// restart: stops the node with SIGTERM then restarts it.
+	Perturb []string `mapstructure:"perturb"`
+
+	// Misbehaviors maps heights to a byzantine behaviour of the validator at
+	// that height, height 0 standing for every other height. The node must
+	// run a binary built from test/e2e/node. Defaults to none. Supported
+	// misbehaviours:
+	//
+	// double-prevote: prevotes as usual, and sends the peers a conflicting
+	//                 prevote. The test stage checks that the evidence is
+	//                 committed.
+	//
+	// equivocating-proposal: proposes two different blocks, each to half of
+	//                        the peers.
+	//
+	// amnesia: forgets the block it is locked on before prevoting.
+	//
+	// withhold-votes: neither prevotes nor precommits.
+	//
+	// invalid-block-parts: proposes a block, but sends block parts which
+	//                      don't match it.
+	Misbehaviors map[string]string `mapstructure:"misbehaviors"`
+}
+
+// LoadManifest loads a testnet manifest from a file.
+func LoadManifest(file string) (Manifest, error) {
+	manifest := Manifest{}
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return manifest, fmt.Errorf("failed to load testnet manifest %q: %v", file, err)
+	}
+	if err := v.Unmarshal(&manifest); err != nil {
+		return manifest, fmt.Errorf("failed to parse testnet manifest %q: %v", file, err)
+	}
+
+	return manifest, nil
+}
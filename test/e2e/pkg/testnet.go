@@ -0,0 +1,305 @@
+// Package e2e contains the testnet model used by the end-to-end test runner,
+// built from a TOML manifest.
+package e2e
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/consensus"
+)
+
+const (
+	// localhost is the address every node listens on; nodes are told apart
+	// by their ports.
+	localhost = "127.0.0.1"
+
+	defaultApp = "persistent_kvstore"
+)
+
+// Mode is the type of a testnet node.
+type Mode string
+
+// Perturbation is a perturbation applied to a running node.
+type Perturbation string
+
+const (
+	ModeValidator Mode = "validator"
+	ModeFull      Mode = "full"
+	ModeSeed      Mode = "seed"
+
+	PerturbationDisconnect Perturbation = "disconnect"
+	PerturbationKill       Perturbation = "kill"
+	PerturbationPause      Perturbation = "pause"
+	PerturbationRestart    Perturbation = "restart"
+)
+
+// Testnet represents a single testnet.
+type Testnet struct {
+	Name       string
+	Dir        string
+	App        string
+	LoadTxRate int
+	Nodes      []*Node
+}
+
+// Node represents a Tendermint node in a testnet.
+type Node struct {
+	Name            string
+	Testnet         *Testnet
+	Mode            Mode
+	Dir             string
+	P2PPort         int
+	RPCPort         int
+	StartAt         int64
+	FastSync        bool
+	Seeds           []*Node
+	PersistentPeers []*Node
+	Perturbations   []Perturbation
+	Misbehaviors    map[int64]consensus.Misbehavior
+}
+
+// LoadTestnet loads a testnet from a manifest file, using the filename to
+// determine the testnet name and directory (from the basename of the file).
+// Node ports are allocated sequentially starting at basePort.
+func LoadTestnet(file string, basePort int) (*Testnet, error) {
+	manifest, err := LoadManifest(file)
+	if err != nil {
+		return nil, err
+	}
+	dir := strings.TrimSuffix(file, filepath.Ext(file))
+	return NewTestnet(manifest, filepath.Base(dir), dir, basePort)
+}
+
+// NewTestnet creates a testnet from a manifest.
+func NewTestnet(manifest Manifest, name, dir string, basePort int) (*Testnet, error) {
+	testnet := &Testnet{
+		Name:       name,
+		Dir:        dir,
+		App:        manifest.App,
+		LoadTxRate: manifest.LoadTxRate,
+		Nodes:      []*Node{},
+	}
+	if testnet.App == "" {
+		testnet.App = defaultApp
+	}
+
+	// Validators come first, as `tendermint testnet` generates validator
+	// directories before the others.
+	nodeNames := []string{}
+	for name := range manifest.Nodes {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Slice(nodeNames, func(i, j int) bool {
+		iVal := manifest.Nodes[nodeNames[i]].isValidator()
+		jVal := manifest.Nodes[nodeNames[j]].isValidator()
+		if iVal != jVal {
+			return iVal
+		}
+		return nodeNames[i] < nodeNames[j]
+	})
+
+	for i, name := range nodeNames {
+		nodeManifest := manifest.Nodes[name]
+		node := &Node{
+			Name:          name,
+			Testnet:       testnet,
+			Mode:          ModeValidator,
+			Dir:           filepath.Join(dir, fmt.Sprintf("node%d", i)),
+			P2PPort:       basePort + 2*i,
+			RPCPort:       basePort + 2*i + 1,
+			StartAt:       nodeManifest.StartAt,
+			FastSync:      nodeManifest.FastSync,
+			Perturbations: []Perturbation{},
+			Misbehaviors:  map[int64]consensus.Misbehavior{},
+		}
+		if nodeManifest.Mode != "" {
+			node.Mode = Mode(nodeManifest.Mode)
+		}
+		for _, p := range nodeManifest.Perturb {
+			node.Perturbations = append(node.Perturbations, Perturbation(p))
+		}
+		for heightString, misbehavior := range nodeManifest.Misbehaviors {
+			height, err := strconv.ParseInt(heightString, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse misbehavior height %q for node %q: %v",
+					heightString, name, err)
+			}
+			node.Misbehaviors[height] = consensus.Misbehavior(misbehavior)
+		}
+		testnet.Nodes = append(testnet.Nodes, node)
+	}
+
+	// We do a second pass to set up seeds and persistent peers, which allows
+	// graph cycles when resolving nodes.
+	for _, name := range nodeNames {
+		nodeManifest := manifest.Nodes[name]
+		node := testnet.LookupNode(name)
+		if node == nil {
+			return nil, fmt.Errorf("unknown node %q", name)
+		}
+		for _, seedName := range nodeManifest.Seeds {
+			seed := testnet.LookupNode(seedName)
+			if seed == nil {
+				return nil, fmt.Errorf("unknown seed %q for node %q", seedName, node.Name)
+			}
+			node.Seeds = append(node.Seeds, seed)
+		}
+		for _, peerName := range nodeManifest.PersistentPeers {
+			peer := testnet.LookupNode(peerName)
+			if peer == nil {
+				return nil, fmt.Errorf("unknown persistent peer %q for node %q", peerName, node.Name)
+			}
+			node.PersistentPeers = append(node.PersistentPeers, peer)
+		}
+
+		// If there are no seeds or persistent peers specified, default to persistent
+		// connections to all other nodes.
+		if len(node.PersistentPeers) == 0 && len(node.Seeds) == 0 {
+			for _, peer := range testnet.Nodes {
+				if peer.Name == node.Name || peer.StartAt > 0 || peer.Mode == ModeSeed {
+					continue
+				}
+				node.PersistentPeers = append(node.PersistentPeers, peer)
+			}
+		}
+	}
+
+	if err := testnet.Validate(); err != nil {
+		return nil, err
+	}
+	return testnet, nil
+}
+
+// Validate validates a testnet.
+func (t Testnet) Validate() error {
+	if t.Name == "" {
+		return errors.New("network has no name")
+	}
+	switch t.App {
+	case "kvstore", "persistent_kvstore":
+	default:
+		return fmt.Errorf("unsupported app %q", t.App)
+	}
+	if t.LoadTxRate < 0 {
+		return errors.New("load_tx_rate can't be negative")
+	}
+	if len(t.Nodes) == 0 {
+		return errors.New("network has no nodes")
+	}
+	if len(t.Validators()) == 0 {
+		return errors.New("network has no validators")
+	}
+	for _, node := range t.Nodes {
+		if err := node.Validate(); err != nil {
+			return fmt.Errorf("invalid node %q: %v", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// Validate validates a node.
+func (n Node) Validate() error {
+	if n.Name == "" {
+		return errors.New("node has no name")
+	}
+	switch n.Mode {
+	case ModeValidator, ModeFull, ModeSeed:
+	default:
+		return fmt.Errorf("invalid mode %q", n.Mode)
+	}
+	if n.StartAt < 0 {
+		return errors.New("start_at can't be negative")
+	}
+	if n.Mode == ModeSeed && n.StartAt > 0 {
+		return errors.New("seed nodes must start at the initial height")
+	}
+	for _, seed := range n.Seeds {
+		if seed.Mode != ModeSeed {
+			return fmt.Errorf("seed %q is not a seed node", seed.Name)
+		}
+	}
+	for _, perturbation := range n.Perturbations {
+		switch perturbation {
+		case PerturbationDisconnect, PerturbationKill, PerturbationPause, PerturbationRestart:
+		default:
+			return fmt.Errorf("invalid perturbation %q", perturbation)
+		}
+	}
+	if len(n.Misbehaviors) > 0 && n.Mode != ModeValidator {
+		return errors.New("only validators can misbehave")
+	}
+	for height, misbehavior := range n.Misbehaviors {
+		if height < 0 {
+			return errors.New("misbehavior heights can't be negative")
+		}
+		if err := misbehavior.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LookupNode looks up a node by name. For now, simply do a linear search.
+func (t Testnet) LookupNode(name string) *Node {
+	for _, node := range t.Nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+	return nil
+}
+
+// Validators returns the validator nodes of the testnet.
+func (t Testnet) Validators() []*Node {
+	validators := []*Node{}
+	for _, node := range t.Nodes {
+		if node.Mode == ModeValidator {
+			validators = append(validators, node)
+		}
+	}
+	return validators
+}
+
+// MisbehaviorsFlag returns the misbehaviours of the node as the value of
+// the --misbehaviors flag of the test/e2e/node binary.
+func (n Node) MisbehaviorsFlag() string {
+	heights := make([]int64, 0, len(n.Misbehaviors))
+	for height := range n.Misbehaviors {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	pairs := make([]string, 0, len(heights))
+	for _, height := range heights {
+		pairs = append(pairs, fmt.Sprintf("%d=%s", height, n.Misbehaviors[height]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// P2PAddress returns the host:port address the node listens for peers on.
+func (n Node) P2PAddress() string {
+	return fmt.Sprintf("%s:%d", localhost, n.P2PPort)
+}
+
+// RPCAddress returns the tcp:// address of the node's RPC server.
+func (n Node) RPCAddress() string {
+	return fmt.Sprintf("tcp://%s:%d", localhost, n.RPCPort)
+}
+
+// PIDFile returns the path of the file holding the PID of the node process.
+func (n Node) PIDFile() string {
+	return filepath.Join(n.Dir, "tendermint.pid")
+}
+
+// LogFile returns the path of the file the node process logs to.
+func (n Node) LogFile() string {
+	return filepath.Join(n.Dir, "tendermint.log")
+}
+
+func (m ManifestNode) isValidator() bool {
+	return m.Mode == "" || m.Mode == string(ModeValidator)
+}
@@ -0,0 +1,72 @@
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTestnet(t *testing.T) {
+	manifest := Manifest{
+		Nodes: map[string]ManifestNode{
+			"seed":  {Mode: "seed"},
+			"full":  {Mode: "full", StartAt: 5, Seeds: []string{"seed"}, Perturb: []string{"kill"}},
+			"val02": {},
+			"val01": {PersistentPeers: []string{"val02"}, Misbehaviors: map[string]string{
+				"10": "double-prevote",
+				"0":  "amnesia",
+			}},
+		},
+	}
+
+	testnet, err := NewTestnet(manifest, "test", "/tmp/test", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, defaultApp, testnet.App)
+
+	// validators come first, as generated by `tendermint testnet`
+	names := []string{}
+	for _, node := range testnet.Nodes {
+		names = append(names, node.Name)
+	}
+	assert.Equal(t, []string{"val01", "val02", "full", "seed"}, names)
+	assert.Len(t, testnet.Validators(), 2)
+
+	val01 := testnet.LookupNode("val01")
+	assert.Equal(t, "/tmp/test/node0", val01.Dir)
+	assert.Equal(t, 1000, val01.P2PPort)
+	assert.Equal(t, 1001, val01.RPCPort)
+	assert.Equal(t, []*Node{testnet.LookupNode("val02")}, val01.PersistentPeers)
+	assert.Equal(t, "0=amnesia,10=double-prevote", val01.MisbehaviorsFlag())
+
+	// without seeds or persistent peers, nodes peer with all initial non-seed nodes
+	val02 := testnet.LookupNode("val02")
+	assert.Equal(t, []*Node{val01}, val02.PersistentPeers)
+
+	full := testnet.LookupNode("full")
+	assert.Equal(t, ModeFull, full.Mode)
+	assert.Equal(t, []*Node{testnet.LookupNode("seed")}, full.Seeds)
+	assert.Empty(t, full.PersistentPeers)
+	assert.Equal(t, []Perturbation{PerturbationKill}, full.Perturbations)
+}
+
+func TestNewTestnetInvalid(t *testing.T) {
+	testCases := map[string]Manifest{
+		"no nodes":      {},
+		"no validators": {Nodes: map[string]ManifestNode{"full": {Mode: "full"}}},
+		"invalid mode":  {Nodes: map[string]ManifestNode{"val": {Mode: "foo"}}},
+		"unknown seed":  {Nodes: map[string]ManifestNode{"val": {Seeds: []string{"foo"}}}},
+		"seed not seed": {Nodes: map[string]ManifestNode{"val": {}, "val2": {Seeds: []string{"val"}}}},
+		"unknown peer":  {Nodes: map[string]ManifestNode{"val": {PersistentPeers: []string{"foo"}}}},
+		"perturbation":  {Nodes: map[string]ManifestNode{"val": {Perturb: []string{"foo"}}}},
+		"app":           {App: "counter", Nodes: map[string]ManifestNode{"val": {}}},
+		"late seed":     {Nodes: map[string]ManifestNode{"val": {}, "seed": {Mode: "seed", StartAt: 3}}},
+		"misbehavior":   {Nodes: map[string]ManifestNode{"val": {Misbehaviors: map[string]string{"1": "foo"}}}},
+		"misb. height":  {Nodes: map[string]ManifestNode{"val": {Misbehaviors: map[string]string{"x": "amnesia"}}}},
+		"misb. full":    {Nodes: map[string]ManifestNode{"val": {}, "full": {Mode: "full", Misbehaviors: map[string]string{"1": "amnesia"}}}},
+	}
+	for name, manifest := range testCases {
+		_, err := NewTestnet(manifest, "test", "/tmp/test", 1000)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+// stopTimeout is how long to wait for a node process to exit.
+const stopTimeout = 20 * time.Second
+
+// startNode starts a node as a local process, logging to the node's log file
+// and recording its PID in the node's PID file so that later invocations of
+// the runner can signal it.
+func startNode(node *e2e.Node, binary string) error {
+	logFile, err := os.OpenFile(node.LogFile(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer logFile.Close()
+
+	args := []string{"node", "--home", node.Dir}
+	if len(node.Misbehaviors) > 0 {
+		args = append(args, "--misbehaviors", node.MisbehaviorsFlag())
+	}
+	cmd := exec.Command(binary, args...) // nolint: gosec
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start node %q: %v", node.Name, err)
+	}
+	// reap the process once it exits, so it can't linger as a zombie
+	go cmd.Wait() // nolint: errcheck
+
+	return ioutil.WriteFile(node.PIDFile(), []byte(strconv.Itoa(cmd.Process.Pid)), 0644)
+}
+
+// stopNode sends the given signal to a node process and waits for it to exit.
+// It is a no-op if the node is not running.
+func stopNode(node *e2e.Node, sig syscall.Signal) error {
+	pid, err := nodePID(node)
+	if err != nil || pid == 0 {
+		return err
+	}
+	if err := syscall.Kill(pid, sig); err != nil && err != syscall.ESRCH {
+		return fmt.Errorf("failed to signal node %q: %v", node.Name, err)
+	}
+
+	deadline := time.Now().Add(stopTimeout)
+	for isRunning(pid) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for node %q to stop", node.Name)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return os.Remove(node.PIDFile())
+}
+
+// signalNode sends a signal to a running node process.
+func signalNode(node *e2e.Node, sig syscall.Signal) error {
+	pid, err := nodePID(node)
+	if err != nil {
+		return err
+	}
+	if pid == 0 {
+		return fmt.Errorf("node %q is not running", node.Name)
+	}
+	return syscall.Kill(pid, sig)
+}
+
+// nodePID returns the PID of a node process, or 0 if it has not been started.
+func nodePID(node *e2e.Node) (int, error) {
+	bz, err := ioutil.ReadFile(node.PIDFile())
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(bz)))
+}
+
+// isRunning checks whether a process exists, using the null signal.
+func isRunning(pid int) bool {
+	return syscall.Kill(pid, syscall.Signal(0)) != syscall.ESRCH
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Load generates transactions against the network at the manifest's
+// load_tx_rate until the given context is canceled. Transactions are sent
+// round-robin to the running nodes; failures are tolerated (nodes may be
+// perturbed while under load), but at least one transaction must be accepted.
+func Load(ctx context.Context, testnet *e2e.Testnet) error {
+	if testnet.LoadTxRate == 0 {
+		return nil
+	}
+
+	nodes := blockNodes(testnet)
+	clients := make([]*rpcclient.HTTP, len(nodes))
+	for i, node := range nodes {
+		clients[i] = nodeClient(node)
+	}
+
+	logger.Info(fmt.Sprintf("Starting transaction load at %v tx/s...", testnet.LoadTxRate))
+	started := time.Now()
+	ticker := time.NewTicker(time.Second / time.Duration(testnet.LoadTxRate))
+	defer ticker.Stop()
+
+	var success, failed int
+	for i := 0; ; i++ {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			logger.Info(fmt.Sprintf("Ending transaction load after %v txs (%.1f tx/s, %v failed)...",
+				success, float64(success)/time.Since(started).Seconds(), failed))
+			if success == 0 {
+				return errors.New("failed to submit any transactions")
+			}
+			return nil
+		}
+
+		res, err := clients[i%len(clients)].BroadcastTxAsync(loadTx())
+		if err != nil || res.Code != 0 {
+			failed++
+			continue
+		}
+		success++
+	}
+}
+
+// loadTx returns a random key=value transaction for the kvstore app.
+func loadTx() types.Tx {
+	return types.Tx(fmt.Sprintf("load-%X=%X", cmn.RandBytes(8), cmn.RandBytes(16)))
+}
+
+// contextWithInterrupt returns a context which is canceled when the process
+// receives SIGINT or SIGTERM, or when the returned cancel function is called.
+func contextWithInterrupt() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(sigs)
+	}()
+	return ctx, cancel
+}
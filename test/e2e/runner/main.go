@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/libs/log"
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+var logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+func main() {
+	NewCLI().Run()
+}
+
+// CLI is the Cobra-based command-line interface.
+type CLI struct {
+	root    *cobra.Command
+	testnet *e2e.Testnet
+	binary  string
+}
+
+// NewCLI sets up the CLI.
+func NewCLI() *CLI {
+	cli := &CLI{}
+	cli.root = &cobra.Command{
+		Use:           "runner",
+		Short:         "End-to-end test runner",
+		SilenceUsage:  true,
+		SilenceErrors: true, // we'll output them ourselves in Run()
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+			if file == "" {
+				return errors.New("a testnet manifest must be given with --file")
+			}
+			basePort, err := cmd.Flags().GetInt("base-port")
+			if err != nil {
+				return err
+			}
+			testnet, err := e2e.LoadTestnet(file, basePort)
+			if err != nil {
+				return err
+			}
+			cli.testnet = testnet
+			cli.binary, err = cmd.Flags().GetString("binary")
+			return err
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cli.runAll()
+			if err != nil {
+				// keep the testnet directory around for inspection
+				if stopErr := Stop(cli.testnet); stopErr != nil {
+					logger.Error(fmt.Sprintf("Failed to stop testnet: %v", stopErr))
+				}
+			}
+			return err
+		},
+	}
+
+	cli.root.PersistentFlags().StringP("file", "f", "", "Testnet TOML manifest")
+	cli.root.PersistentFlags().String("binary", "tendermint", "Tendermint binary used to set up and run the nodes")
+	cli.root.PersistentFlags().Int("base-port", 5701, "First port allocated to the testnet nodes")
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "setup",
+		Short: "Generates the testnet directory and configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Setup(cli.testnet, cli.binary)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "start",
+		Short: "Starts the testnet, waiting for nodes to become available",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := os.Stat(cli.testnet.Dir)
+			if os.IsNotExist(err) {
+				err = Setup(cli.testnet, cli.binary)
+			}
+			if err != nil {
+				return err
+			}
+			return Start(cli.testnet, cli.binary)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "perturb",
+		Short: "Perturbs the testnet, e.g. by restarting or disconnecting nodes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Perturb(cli.testnet, cli.binary)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "wait",
+		Short: "Waits for a few blocks to be produced and all nodes to catch up",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Wait(cli.testnet, 5)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "stop",
+		Short: "Stops the testnet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Stop(cli.testnet)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "load",
+		Short: "Generates transaction load until the command is canceled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := contextWithInterrupt()
+			defer cancel()
+			return Load(ctx, cli.testnet)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "test",
+		Short: "Runs test cases against a running testnet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Test(cli.testnet)
+		},
+	})
+
+	cli.root.AddCommand(&cobra.Command{
+		Use:   "cleanup",
+		Short: "Removes the testnet directory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Cleanup(cli.testnet)
+		},
+	})
+
+	return cli
+}
+
+// runAll sets up and starts the testnet, generates transaction load while
+// perturbing the nodes, runs the tests and finally tears the testnet down.
+func (cli *CLI) runAll() error {
+	if err := Cleanup(cli.testnet); err != nil {
+		return err
+	}
+	if err := Setup(cli.testnet, cli.binary); err != nil {
+		return err
+	}
+	if err := Start(cli.testnet, cli.binary); err != nil {
+		return err
+	}
+
+	chLoadResult := make(chan error, 1)
+	ctx, loadCancel := contextWithInterrupt()
+	defer loadCancel()
+	go func() {
+		err := Load(ctx, cli.testnet)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Transaction load failed: %v", err))
+		}
+		chLoadResult <- err
+	}()
+
+	if err := Wait(cli.testnet, 5); err != nil { // allow some txs to go through
+		return err
+	}
+	if err := Perturb(cli.testnet, cli.binary); err != nil {
+		return err
+	}
+	if err := Wait(cli.testnet, 5); err != nil { // allow some txs to go through
+		return err
+	}
+
+	loadCancel()
+	if err := <-chLoadResult; err != nil {
+		return err
+	}
+	if err := Wait(cli.testnet, 5); err != nil { // wait for network to settle before tests
+		return err
+	}
+	if err := Test(cli.testnet); err != nil {
+		return err
+	}
+	if err := Stop(cli.testnet); err != nil {
+		return err
+	}
+	return Cleanup(cli.testnet)
+}
+
+// Run runs the CLI.
+func (cli *CLI) Run() {
+	if err := cli.root.Execute(); err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+const (
+	sigTerm = syscall.SIGTERM
+
+	// disconnectDuration must exceed the p2p ping interval plus pong timeout
+	// (60s and 45s by default), so that peers drop the suspended node.
+	disconnectDuration = 2 * time.Minute
+
+	pauseDuration = 10 * time.Second
+)
+
+// Perturb applies the perturbations listed in the manifest to each node in
+// turn, waiting for the node to catch up with the network after each one.
+func Perturb(testnet *e2e.Testnet, binary string) error {
+	for _, node := range testnet.Nodes {
+		for _, perturbation := range node.Perturbations {
+			if err := PerturbNode(node, perturbation, binary); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PerturbNode perturbs a node with a given perturbation, returning once the
+// node has recovered and caught up with the rest of the network.
+func PerturbNode(node *e2e.Node, perturbation e2e.Perturbation, binary string) error {
+	switch perturbation {
+	case e2e.PerturbationDisconnect:
+		logger.Info(fmt.Sprintf("Disconnecting node %v...", node.Name))
+		if err := suspendNode(node, disconnectDuration); err != nil {
+			return err
+		}
+
+	case e2e.PerturbationKill:
+		logger.Info(fmt.Sprintf("Killing node %v...", node.Name))
+		if err := stopNode(node, syscall.SIGKILL); err != nil {
+			return err
+		}
+		if err := startNode(node, binary); err != nil {
+			return err
+		}
+
+	case e2e.PerturbationPause:
+		logger.Info(fmt.Sprintf("Pausing node %v...", node.Name))
+		if err := suspendNode(node, pauseDuration); err != nil {
+			return err
+		}
+
+	case e2e.PerturbationRestart:
+		logger.Info(fmt.Sprintf("Restarting node %v...", node.Name))
+		if err := stopNode(node, sigTerm); err != nil {
+			return err
+		}
+		if err := startNode(node, binary); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unexpected perturbation %q", perturbation)
+	}
+
+	if node.Mode == e2e.ModeSeed {
+		return waitForNode(node, 0, 15*time.Second)
+	}
+
+	// Wait for the node to catch up with the others.
+	running, err := runningNodes(node.Testnet)
+	if err != nil {
+		return err
+	}
+	height, err := networkHeight(running)
+	if err != nil {
+		return err
+	}
+	if err := waitForNode(node, height, 2*time.Minute); err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("Node %v recovered at height %v", node.Name, height))
+	return nil
+}
+
+// suspendNode stops a node process with SIGSTOP for the given duration, then
+// resumes it.
+func suspendNode(node *e2e.Node, duration time.Duration) error {
+	if err := signalNode(node, syscall.SIGSTOP); err != nil {
+		return err
+	}
+	time.Sleep(duration)
+	return signalNode(node, syscall.SIGCONT)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+// nodeClient returns an RPC client for a node.
+func nodeClient(node *e2e.Node) *rpcclient.HTTP {
+	return rpcclient.NewHTTP(node.RPCAddress(), "/websocket")
+}
+
+// waitForHeight waits for the network to reach a certain height (or above),
+// as seen by any of the given nodes. It returns the height reached, and fails
+// if the network stops making progress.
+func waitForHeight(nodes []*e2e.Node, height int64) (int64, error) {
+	var (
+		maxHeight    int64
+		lastIncrease = time.Now()
+		timeout      = 20 * time.Second
+	)
+	for {
+		for _, node := range nodes {
+			status, err := nodeClient(node).Status()
+			if err != nil {
+				continue
+			}
+			if status.SyncInfo.LatestBlockHeight > maxHeight {
+				maxHeight = status.SyncInfo.LatestBlockHeight
+				lastIncrease = time.Now()
+			}
+			if maxHeight >= height {
+				return maxHeight, nil
+			}
+		}
+
+		if len(nodes) == 0 {
+			return 0, errors.New("no nodes to wait for")
+		}
+		if time.Since(lastIncrease) >= timeout {
+			if maxHeight == 0 {
+				return 0, errors.New("chain stalled at unknown height")
+			}
+			return 0, fmt.Errorf("chain stalled at height %v", maxHeight)
+		}
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// networkHeight returns the highest block height reported by any of the given
+// nodes.
+func networkHeight(nodes []*e2e.Node) (int64, error) {
+	var (
+		height  int64
+		lastErr error
+	)
+	for _, node := range nodes {
+		status, err := nodeClient(node).Status()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if status.SyncInfo.LatestBlockHeight > height {
+			height = status.SyncInfo.LatestBlockHeight
+		}
+	}
+	if height == 0 && lastErr != nil {
+		return 0, fmt.Errorf("failed to query network height: %v", lastErr)
+	}
+	return height, nil
+}
+
+// waitForNode waits for a node to become available and catch up to the given
+// block height.
+func waitForNode(node *e2e.Node, height int64, timeout time.Duration) error {
+	client := nodeClient(node)
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := client.Status()
+		if err == nil && status.SyncInfo.LatestBlockHeight >= height {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("timed out waiting for node %q: %v", node.Name, err)
+			}
+			return fmt.Errorf("timed out waiting for node %q to reach height %v (at %v)",
+				node.Name, height, status.SyncInfo.LatestBlockHeight)
+		}
+		time.Sleep(300 * time.Millisecond)
+	}
+}
+
+// blockNodes returns the nodes which keep a blockchain, i.e. all but seeds.
+func blockNodes(testnet *e2e.Testnet) []*e2e.Node {
+	nodes := []*e2e.Node{}
+	for _, node := range testnet.Nodes {
+		if node.Mode != e2e.ModeSeed {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// runningNodes returns the block nodes which have been started.
+func runningNodes(testnet *e2e.Testnet) ([]*e2e.Node, error) {
+	nodes := []*e2e.Node{}
+	for _, node := range blockNodes(testnet) {
+		pid, err := nodePID(node)
+		if err != nil {
+			return nil, err
+		}
+		if pid != 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+// Setup sets up the testnet directory and configuration, using the
+// `tendermint testnet` command to generate the node directories, keys and
+// genesis file.
+func Setup(testnet *e2e.Testnet, binary string) error {
+	logger.Info(fmt.Sprintf("Generating testnet files in %q", testnet.Dir))
+
+	nValidators := len(testnet.Validators())
+	err := execVerbose(binary, "testnet",
+		"--v", fmt.Sprintf("%d", nValidators),
+		"--n", fmt.Sprintf("%d", len(testnet.Nodes)-nValidators),
+		"--o", testnet.Dir,
+		"--populate-persistent-peers=false",
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range testnet.Nodes {
+		config, err := MakeConfig(node)
+		if err != nil {
+			return err
+		}
+		cfg.WriteConfigFile(filepath.Join(node.Dir, "config", "config.toml"), config)
+	}
+
+	return nil
+}
+
+// MakeConfig generates a Tendermint config for a node, overriding the
+// defaults written by `tendermint testnet`.
+func MakeConfig(node *e2e.Node) (*cfg.Config, error) {
+	config := cfg.DefaultConfig()
+	config.SetRoot(node.Dir)
+	config.Moniker = node.Name
+	config.ProxyApp = node.Testnet.App
+	config.FastSyncMode = node.FastSync
+	config.LogLevel = "main:info,state:info,*:error"
+	config.RPC.ListenAddress = node.RPCAddress()
+	config.P2P.ListenAddress = "tcp://" + node.P2PAddress()
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+
+	switch node.Mode {
+	case e2e.ModeSeed:
+		config.P2P.SeedMode = true
+		config.P2P.PexReactor = true
+	case e2e.ModeValidator, e2e.ModeFull:
+	default:
+		return nil, fmt.Errorf("unexpected mode %q", node.Mode)
+	}
+
+	seeds, err := peerAddresses(node.Seeds)
+	if err != nil {
+		return nil, err
+	}
+	config.P2P.Seeds = seeds
+
+	persistentPeers, err := peerAddresses(node.PersistentPeers)
+	if err != nil {
+		return nil, err
+	}
+	config.P2P.PersistentPeers = persistentPeers
+
+	return config, nil
+}
+
+// peerAddresses returns the comma-separated ID@host:port addresses of the
+// given nodes, reading their IDs from the generated node keys.
+func peerAddresses(nodes []*e2e.Node) (string, error) {
+	addresses := []string{}
+	for _, node := range nodes {
+		config := cfg.DefaultConfig()
+		config.SetRoot(node.Dir)
+		nodeKey, err := p2p.LoadNodeKey(config.NodeKeyFile())
+		if err != nil {
+			return "", fmt.Errorf("failed to load node key for %q: %v", node.Name, err)
+		}
+		addresses = append(addresses, p2p.IDAddressString(nodeKey.ID(), node.P2PAddress()))
+	}
+	return strings.Join(addresses, ","), nil
+}
+
+// Cleanup removes the testnet directory.
+func Cleanup(testnet *e2e.Testnet) error {
+	if testnet.Dir == "" {
+		return fmt.Errorf("no testnet directory set")
+	}
+	_, err := os.Stat(testnet.Dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("Removing testnet directory %q", testnet.Dir))
+	return os.RemoveAll(testnet.Dir)
+}
+
+// execVerbose runs a command, piping its output to ours.
+func execVerbose(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run %v: %v", append([]string{name}, args...), err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+)
+
+// Start starts the testnet nodes. Nodes starting at the initial height are
+// started first, then the others are started as the network reaches their
+// start height.
+func Start(testnet *e2e.Testnet, binary string) error {
+	// Sort nodes by starting order, seeds first.
+	nodeQueue := append([]*e2e.Node{}, testnet.Nodes...)
+	sort.SliceStable(nodeQueue, func(i, j int) bool {
+		a, b := nodeQueue[i], nodeQueue[j]
+		switch {
+		case a.Mode == b.Mode:
+			return a.StartAt < b.StartAt
+		case a.Mode == e2e.ModeSeed:
+			return true
+		case b.Mode == e2e.ModeSeed:
+			return false
+		default:
+			return a.StartAt < b.StartAt
+		}
+	})
+
+	logger.Info(fmt.Sprintf("Starting testnet %q with %v nodes", testnet.Name, len(testnet.Nodes)))
+	for len(nodeQueue) > 0 && nodeQueue[0].StartAt == 0 {
+		node := nodeQueue[0]
+		nodeQueue = nodeQueue[1:]
+		if err := startNode(node, binary); err != nil {
+			return err
+		}
+		if err := waitForNode(node, 0, 15*time.Second); err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Node %v up on %v", node.Name, node.RPCAddress()))
+	}
+
+	// Wait for the initial nodes to produce a block before starting the rest.
+	if _, err := waitForHeight(blockNodes(testnet), 1); err != nil {
+		return err
+	}
+
+	for _, node := range nodeQueue {
+		logger.Info(fmt.Sprintf("Waiting for height %v to start node %v", node.StartAt, node.Name))
+		running, err := runningNodes(testnet)
+		if err != nil {
+			return err
+		}
+		if _, err := waitForHeight(running, node.StartAt); err != nil {
+			return err
+		}
+		if err := startNode(node, binary); err != nil {
+			return err
+		}
+		if err := waitForNode(node, node.StartAt, 1*time.Minute); err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Node %v up on %v", node.Name, node.RPCAddress()))
+	}
+
+	return nil
+}
+
+// Stop stops all running testnet nodes.
+func Stop(testnet *e2e.Testnet) error {
+	logger.Info(fmt.Sprintf("Stopping testnet %q", testnet.Name))
+	for _, node := range testnet.Nodes {
+		if err := stopNode(node, sigTerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Wait waits for the network to produce a number of blocks from its current
+// height, and for all running nodes to catch up with it.
+func Wait(testnet *e2e.Testnet, blocks int64) error {
+	running, err := runningNodes(testnet)
+	if err != nil {
+		return err
+	}
+	height, err := networkHeight(running)
+	if err != nil {
+		return err
+	}
+	waitFor := height + blocks
+	logger.Info(fmt.Sprintf("Waiting for all nodes to reach height %v...", waitFor))
+	for _, node := range running {
+		if err := waitForNode(node, waitFor, time.Duration(blocks)*10*time.Second); err != nil {
+			return err
+		}
+	}
+	return nil
+}
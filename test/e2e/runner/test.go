@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/consensus"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	e2e "github.com/tendermint/tendermint/test/e2e/pkg"
+	"github.com/tendermint/tendermint/types"
+)
+
+// maxBlockchainInfoRange is the number of block metas returned by a single
+// /blockchain RPC call.
+const maxBlockchainInfoRange = 20
+
+// Test runs assertions against the running testnet: every node must have
+// produced blocks past its start height, all nodes must agree on the block
+// and app hash at every height, transactions must have been committed if load
+// was generated, and evidence of the double prevotes of byzantine validators
+// must have been committed.
+func Test(testnet *e2e.Testnet) error {
+	nodes, err := runningNodes(testnet)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return errors.New("no running nodes to test")
+	}
+	logger.Info(fmt.Sprintf("Testing %v nodes...", len(nodes)))
+
+	// Block production: all nodes must have made progress.
+	heights := make(map[string]int64, len(nodes))
+	minHeight := int64(-1)
+	for _, node := range nodes {
+		status, err := nodeClient(node).Status()
+		if err != nil {
+			return fmt.Errorf("node %q is unavailable: %v", node.Name, err)
+		}
+		height := status.SyncInfo.LatestBlockHeight
+		if height <= node.StartAt+1 {
+			return fmt.Errorf("node %q has not produced any blocks since starting (at height %v)",
+				node.Name, height)
+		}
+		heights[node.Name] = height
+		if minHeight < 0 || height < minHeight {
+			minHeight = height
+		}
+	}
+
+	// Block and app hash agreement: all nodes must have the same blocks, and
+	// thus the same app hashes, up to the lowest common height.
+	var reference []*types.BlockMeta
+	for _, node := range nodes {
+		metas, err := fetchBlockMetas(node, minHeight)
+		if err != nil {
+			return err
+		}
+		if reference == nil {
+			reference = metas
+			continue
+		}
+		for i, meta := range metas {
+			ref := reference[i]
+			if !bytes.Equal(meta.Header.AppHash, ref.Header.AppHash) {
+				return fmt.Errorf("app hash mismatch at height %v: node %q has %v, node %q has %v",
+					meta.Header.Height, node.Name, meta.Header.AppHash, nodes[0].Name, ref.Header.AppHash)
+			}
+			if !meta.BlockID.Equals(ref.BlockID) {
+				return fmt.Errorf("block mismatch at height %v: node %q has %v, node %q has %v",
+					meta.Header.Height, node.Name, meta.BlockID, nodes[0].Name, ref.BlockID)
+			}
+		}
+	}
+
+	// Transactions: load must have resulted in committed transactions.
+	if testnet.LoadTxRate > 0 {
+		last := reference[len(reference)-1]
+		if last.Header.TotalTxs == 0 {
+			return fmt.Errorf("no transactions committed by height %v", last.Header.Height)
+		}
+		logger.Info(fmt.Sprintf("%v transactions committed by height %v",
+			last.Header.TotalTxs, last.Header.Height))
+	}
+
+	// Evidence: the double prevotes of byzantine validators must have been
+	// committed as evidence.
+	for _, node := range testnet.Validators() {
+		if err := testEvidence(node, nodes[0], minHeight); err != nil {
+			return err
+		}
+	}
+
+	logger.Info(fmt.Sprintf("All nodes agree on %v blocks", minHeight))
+	return nil
+}
+
+// testEvidence checks that evidence of the first double prevote of a
+// validator has been committed by maxHeight, as seen by the given node.
+func testEvidence(validator, node *e2e.Node, maxHeight int64) error {
+	from := int64(-1)
+	for height, misbehavior := range validator.Misbehaviors {
+		if misbehavior != consensus.MisbehaviorDoublePrevote {
+			continue
+		}
+		if height == 0 {
+			height = 1
+		}
+		if from < 0 || height < from {
+			from = height
+		}
+	}
+	if from < 0 {
+		return nil
+	}
+	if from >= maxHeight {
+		return fmt.Errorf("validator %q did not get to double prevote at height %v, the network is at height %v",
+			validator.Name, from, maxHeight)
+	}
+
+	status, err := nodeClient(validator).Status()
+	if err != nil {
+		return fmt.Errorf("node %q is unavailable: %v", validator.Name, err)
+	}
+	address := status.ValidatorInfo.Address
+
+	client := nodeClient(node)
+	for height := from; height <= maxHeight; height++ {
+		h := height
+		res, err := client.Block(&h)
+		if err != nil {
+			return fmt.Errorf("failed to fetch block %v from node %q: %v", height, node.Name, err)
+		}
+		for _, ev := range res.Block.Evidence.Evidence {
+			if _, ok := ev.(*types.DuplicateVoteEvidence); ok && bytes.Equal(ev.Address(), address) {
+				logger.Info(fmt.Sprintf("Evidence of the double prevote of validator %v committed at height %v",
+					validator.Name, height))
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("no evidence of the double prevote of validator %q at height %v committed by height %v",
+		validator.Name, from, maxHeight)
+}
+
+// fetchBlockMetas fetches the block metas of a node from height 1 up to and
+// including maxHeight, in ascending order.
+func fetchBlockMetas(node *e2e.Node, maxHeight int64) ([]*types.BlockMeta, error) {
+	client := nodeClient(node)
+	metas := make([]*types.BlockMeta, 0, maxHeight)
+	for min := int64(1); min <= maxHeight; min += maxBlockchainInfoRange {
+		max := cmn.MinInt64(min+maxBlockchainInfoRange-1, maxHeight)
+		res, err := client.BlockchainInfo(min, max)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch blocks %v-%v from node %q: %v", min, max, node.Name, err)
+		}
+		// metas are returned in descending order
+		for i := len(res.BlockMetas) - 1; i >= 0; i-- {
+			metas = append(metas, res.BlockMetas[i])
+		}
+	}
+	if int64(len(metas)) != maxHeight {
+		return nil, fmt.Errorf("expected %v blocks from node %q, got %v", maxHeight, node.Name, len(metas))
+	}
+	return metas, nil
+}
@@ -300,15 +300,16 @@ func (b *Block) Unmarshal(bs []byte) error {
 
 //-----------------------------------------------------------------------------
 
-// MaxDataBytes returns the maximum size of block's data.
+// MaxDataBytes returns the maximum size of block's data, given the size of
+// its evidence (see EvidenceList.ByteSize).
 //
 // XXX: Panics on negative result.
-func MaxDataBytes(maxBytes int64, valsCount, evidenceCount int) int64 {
+func MaxDataBytes(maxBytes int64, valsCount int, evidenceBytes int64) int64 {
 	maxDataBytes := maxBytes -
 		MaxAminoOverheadForBlock -
 		MaxHeaderBytes -
 		int64(valsCount)*MaxVoteBytes -
-		int64(evidenceCount)*MaxEvidenceBytes
+		evidenceBytes
 
 	if maxDataBytes < 0 {
 		panic(fmt.Sprintf(
@@ -554,6 +555,22 @@ func (commit *Commit) BitArray() *cmn.BitArray {
 	return commit.bitArray
 }
 
+// StripExtensions returns a copy of the commit whose precommits carry no
+// vote extensions, so that it can be included in a block.
+func (commit *Commit) StripExtensions() *Commit {
+	precommits := make([]*Vote, len(commit.Precommits))
+	for i, precommit := range commit.Precommits {
+		if precommit != nil && (len(precommit.Extension) > 0 || len(precommit.ExtensionSignature) > 0) {
+			precommit = precommit.StripExtension()
+		}
+		precommits[i] = precommit
+	}
+	return &Commit{
+		BlockID:    commit.BlockID,
+		Precommits: precommits,
+	}
+}
+
 // GetByIndex returns the vote corresponding to a given validator index
 func (commit *Commit) GetByIndex(index int) *Vote {
 	return commit.Precommits[index]
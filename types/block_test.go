@@ -209,7 +209,7 @@ func TestCommit(t *testing.T) {
 	require.NotNil(t, commit.BitArray())
 	assert.Equal(t, cmn.NewBitArray(10).Size(), commit.BitArray().Size())
 
-	assert.Equal(t, voteSet.GetByIndex(0), commit.GetByIndex(0))
+	assert.Equal(t, voteSet.GetByIndex(0).StripExtension(), commit.GetByIndex(0))
 	assert.True(t, commit.IsCommit())
 }
 
@@ -289,7 +289,7 @@ func TestBlockMaxDataBytes(t *testing.T) {
 	testCases := []struct {
 		maxBytes      int64
 		valsCount     int
-		evidenceCount int
+		evidenceBytes int64
 		panics        bool
 		result        int64
 	}{
@@ -298,17 +298,19 @@ func TestBlockMaxDataBytes(t *testing.T) {
 		2: {886, 1, 0, true, 0},
 		3: {887, 1, 0, false, 0},
 		4: {888, 1, 0, false, 1},
+		5: {888, 1, 1, false, 0},
+		6: {888, 1, 2, true, 0},
 	}
 
 	for i, tc := range testCases {
 		if tc.panics {
 			assert.Panics(t, func() {
-				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceCount)
+				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceBytes)
 			}, "#%v", i)
 		} else {
 			assert.Equal(t,
 				tc.result,
-				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceCount),
+				MaxDataBytes(tc.maxBytes, tc.valsCount, tc.evidenceBytes),
 				"#%v", i)
 		}
 	}
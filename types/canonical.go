@@ -41,6 +41,13 @@ type CanonicalVote struct {
 	ChainID   string
 }
 
+type CanonicalVoteExtension struct {
+	Extension []byte
+	Height    int64 `binary:"fixed64"`
+	Round     int64 `binary:"fixed64"`
+	ChainID   string
+}
+
 //-----------------------------------
 // Canonicalize the structs
 
@@ -81,6 +88,15 @@ func CanonicalizeVote(chainID string, vote *Vote) CanonicalVote {
 	}
 }
 
+func CanonicalizeVoteExtension(chainID string, vote *Vote) CanonicalVoteExtension {
+	return CanonicalVoteExtension{
+		Extension: vote.Extension,
+		Height:    vote.Height,
+		Round:     int64(vote.Round),
+		ChainID:   chainID,
+	}
+}
+
 // CanonicalTime can be used to stringify time in a canonical way.
 func CanonicalTime(t time.Time) string {
 	// Note that sending time over amino resets it to
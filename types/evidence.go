@@ -14,7 +14,9 @@ import (
 )
 
 const (
-	// MaxEvidenceBytes is a maximum size of any evidence (including amino overhead).
+	// MaxEvidenceBytes is a maximum size of any evidence (including amino
+	// overhead), except for LightClientAttackEvidence, whose size depends on
+	// the size of the validator set.
 	MaxEvidenceBytes int64 = 484
 )
 
@@ -68,6 +70,7 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -203,6 +206,133 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 
 //-----------------------------------------------------------------
 
+// LightClientAttackEvidence contains evidence a validator signed a header
+// conflicting with the chain at the same height, along with enough of the
+// validators of the conflicting header to fool light clients into trusting
+// it. There is one for each byzantine validator.
+//
+// The attack is a lunatic attack if the conflicting header commits to
+// another validator set than the chain's, and an equivocation otherwise (the
+// validators signed two blocks in the same round).
+type LightClientAttackEvidence struct {
+	PubKey                crypto.PubKey
+	ConflictingHeader     SignedHeader
+	ConflictingValidators *ValidatorSet
+}
+
+var _ Evidence = &LightClientAttackEvidence{}
+
+// String returns a string representation of the evidence.
+func (lcae *LightClientAttackEvidence) String() string {
+	return fmt.Sprintf("LightClientAttackEvidence{Validator: %X, Header: %v}",
+		lcae.Address(), lcae.ConflictingHeader.Hash())
+}
+
+// Height returns the height of the conflicting header.
+func (lcae *LightClientAttackEvidence) Height() int64 {
+	return lcae.ConflictingHeader.Height
+}
+
+// Address returns the address of the validator.
+func (lcae *LightClientAttackEvidence) Address() []byte {
+	return lcae.PubKey.Address()
+}
+
+// Bytes returns the bytes of the evidence.
+func (lcae *LightClientAttackEvidence) Bytes() []byte {
+	return cdcEncode(lcae)
+}
+
+// Hash returns the hash of the evidence.
+func (lcae *LightClientAttackEvidence) Hash() []byte {
+	return tmhash.Sum(cdcEncode(lcae))
+}
+
+// AttackType returns the ABCI evidence type of the attack, given the
+// validators of the chain at the height of the conflicting header.
+func (lcae *LightClientAttackEvidence) AttackType(vals *ValidatorSet) string {
+	if bytes.Equal(lcae.ConflictingHeader.ValidatorsHash, vals.Hash()) {
+		return ABCIEvidenceTypeLightClientEquivocation
+	}
+	return ABCIEvidenceTypeLightClientLunatic
+}
+
+// Verify returns an error if the conflicting header is not signed by +2/3
+// of the conflicting validators, including the validator with the given
+// public key. It doesn't check that the header conflicts with the chain,
+// which takes the state (see state.VerifyEvidence).
+func (lcae *LightClientAttackEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	sh := lcae.ConflictingHeader
+	if err := sh.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("LightClientAttackEvidence Error: invalid conflicting header: %v", err)
+	}
+
+	// The conflicting validators must be the ones of the header, and have
+	// signed it.
+	if !bytes.Equal(lcae.ConflictingValidators.Hash(), sh.ValidatorsHash) {
+		return fmt.Errorf("LightClientAttackEvidence Error: conflicting validators hash %X doesn't match header's %X",
+			lcae.ConflictingValidators.Hash(), sh.ValidatorsHash)
+	}
+	if err := lcae.ConflictingValidators.VerifyCommit(chainID, sh.Commit.BlockID, sh.Height, sh.Commit); err != nil {
+		return fmt.Errorf("LightClientAttackEvidence Error: invalid conflicting commit: %v", err)
+	}
+
+	// pubkey must match address (this should already be true, sanity check)
+	addr := lcae.PubKey.Address()
+	if !bytes.Equal(pubKey.Address(), addr) {
+		return fmt.Errorf("LightClientAttackEvidence FAILED SANITY CHECK - address (%X) doesn't match pubkey (%v - %X)",
+			addr, pubKey, pubKey.Address())
+	}
+
+	// The validator must be one of the conflicting validators, with the same
+	// key, and have signed the header. VerifyCommit verified its signature.
+	idx, val := lcae.ConflictingValidators.GetByAddress(addr)
+	if val == nil {
+		return fmt.Errorf("LightClientAttackEvidence Error: validator %X is not a conflicting validator", addr)
+	}
+	if !val.PubKey.Equals(pubKey) {
+		return fmt.Errorf("LightClientAttackEvidence Error: validator %X has another key in the conflicting validators", addr)
+	}
+	precommit := sh.Commit.Precommits[idx]
+	if precommit == nil || !precommit.BlockID.Equals(sh.Commit.BlockID) {
+		return fmt.Errorf("LightClientAttackEvidence Error: validator %X didn't sign the conflicting header", addr)
+	}
+
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal.
+func (lcae *LightClientAttackEvidence) Equal(ev Evidence) bool {
+	if _, ok := ev.(*LightClientAttackEvidence); !ok {
+		return false
+	}
+
+	// just check their hashes
+	return bytes.Equal(tmhash.Sum(cdcEncode(lcae)), tmhash.Sum(cdcEncode(ev)))
+}
+
+// ValidateBasic performs basic validation.
+func (lcae *LightClientAttackEvidence) ValidateBasic() error {
+	if lcae.PubKey == nil || len(lcae.PubKey.Bytes()) == 0 {
+		return errors.New("Empty PubKey")
+	}
+	if lcae.ConflictingHeader.Header == nil || lcae.ConflictingHeader.Commit == nil {
+		return errors.New("Empty conflicting header or commit")
+	}
+	if lcae.ConflictingHeader.Height <= 0 {
+		return errors.New("Conflicting header has a non-positive height")
+	}
+	if err := lcae.ConflictingHeader.Commit.ValidateBasic(); err != nil {
+		return fmt.Errorf("Invalid conflicting commit: %v", err)
+	}
+	if lcae.ConflictingValidators == nil || lcae.ConflictingValidators.Size() == 0 {
+		return errors.New("Empty conflicting validators")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------
+
 // UNSTABLE
 type MockRandomGoodEvidence struct {
 	MockGoodEvidence
@@ -297,6 +427,21 @@ func (evl EvidenceList) String() string {
 	return s
 }
 
+// ByteSize returns the maximum size of the evidence in a block (including
+// amino overhead): MaxEvidenceBytes for each piece of evidence, except for
+// light client attack evidence, which takes its encoded size.
+func (evl EvidenceList) ByteSize() int64 {
+	size := int64(0)
+	for _, ev := range evl {
+		if _, ok := ev.(*LightClientAttackEvidence); ok {
+			size += int64(len(cdc.MustMarshalBinaryLengthPrefixed(ev)))
+		} else {
+			size += MaxEvidenceBytes
+		}
+	}
+	return size
+}
+
 // Has returns true if the evidence is in the EvidenceList.
 func (evl EvidenceList) Has(evidence Evidence) bool {
 	for _, ev := range evl {
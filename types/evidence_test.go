@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 type voteData struct {
@@ -157,3 +158,107 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 		})
 	}
 }
+
+// makeLightClientAttackEvidence returns evidence against the first of the
+// validators signing a conflicting header at height 10, and the signers.
+func makeLightClientAttackEvidence(t *testing.T, chainID string) (*LightClientAttackEvidence, []PrivValidator) {
+	vals, privVals := RandValidatorSet(4, 10)
+	header := &Header{
+		ChainID:        chainID,
+		Height:         10,
+		Time:           tmtime.Now(),
+		ValidatorsHash: vals.Hash(),
+	}
+	blockID := makeBlockID(header.Hash(), 1, tmhash.Sum([]byte("partshash")))
+	voteSet := NewVoteSet(chainID, header.Height, 1, PrecommitType, vals)
+	commit, err := MakeCommit(blockID, header.Height, 1, voteSet, privVals)
+	require.NoError(t, err)
+
+	return &LightClientAttackEvidence{
+		PubKey:                privVals[0].GetPubKey(),
+		ConflictingHeader:     SignedHeader{Header: header, Commit: commit},
+		ConflictingValidators: vals,
+	}, privVals
+}
+
+func TestLightClientAttackEvidence(t *testing.T) {
+	const chainID = "mychain"
+	ev, privVals := makeLightClientAttackEvidence(t, chainID)
+	pubKey := privVals[0].GetPubKey()
+
+	assert.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, ev.Verify(chainID, pubKey))
+	assert.EqualValues(t, 10, ev.Height())
+	assert.EqualValues(t, pubKey.Address(), ev.Address())
+	assert.True(t, ev.Equal(ev))
+	assert.False(t, ev.Equal(randomDuplicatedVoteEvidence()))
+
+	// it is registered with amino, to be gossiped and included in blocks
+	var ev2 Evidence
+	require.NoError(t, cdc.UnmarshalBinaryBare(ev.Bytes(), &ev2))
+	assert.True(t, ev.Equal(ev2))
+
+	// wrong chain
+	assert.Error(t, ev.Verify("mychain2", pubKey))
+	// wrong key
+	assert.Error(t, ev.Verify(chainID, privVals[1].GetPubKey()))
+
+	// the validators must be the header's
+	other, _ := makeLightClientAttackEvidence(t, chainID)
+	otherVals := other.ConflictingValidators
+	lcae := *ev
+	lcae.ConflictingValidators = otherVals
+	assert.Error(t, lcae.Verify(chainID, pubKey))
+
+	// the validator must have signed the header
+	lcae = *ev
+	commit := *ev.ConflictingHeader.Commit
+	commit.Precommits = append([]*Vote{nil}, commit.Precommits[1:]...)
+	lcae.ConflictingHeader.Commit = &commit
+	assert.NoError(t, lcae.ValidateBasic())
+	assert.Error(t, lcae.Verify(chainID, pubKey))
+
+	// the validator must be a conflicting validator
+	lcae = *ev
+	lcae.PubKey = NewMockPV().GetPubKey()
+	assert.Error(t, lcae.Verify(chainID, lcae.PubKey))
+
+	// equivocation if the validators are the chain's, lunatic otherwise
+	assert.Equal(t, ABCIEvidenceTypeLightClientEquivocation, ev.AttackType(ev.ConflictingValidators))
+	assert.Equal(t, ABCIEvidenceTypeLightClientLunatic, ev.AttackType(otherVals))
+}
+
+func TestLightClientAttackEvidenceValidation(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*LightClientAttackEvidence)
+		expectErr        bool
+	}{
+		{"Good LightClientAttackEvidence", func(ev *LightClientAttackEvidence) {}, false},
+		{"Nil pubkey", func(ev *LightClientAttackEvidence) { ev.PubKey = nil }, true},
+		{"Nil header", func(ev *LightClientAttackEvidence) { ev.ConflictingHeader.Header = nil }, true},
+		{"Nil commit", func(ev *LightClientAttackEvidence) { ev.ConflictingHeader.Commit = nil }, true},
+		{"Invalid commit", func(ev *LightClientAttackEvidence) {
+			ev.ConflictingHeader.Commit = &Commit{BlockID: ev.ConflictingHeader.Commit.BlockID}
+		}, true},
+		{"Nil validators", func(ev *LightClientAttackEvidence) { ev.ConflictingValidators = nil }, true},
+		{"Empty validators", func(ev *LightClientAttackEvidence) { ev.ConflictingValidators = &ValidatorSet{} }, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			ev, _ := makeLightClientAttackEvidence(t, "mychain")
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestEvidenceListByteSize(t *testing.T) {
+	ev, _ := makeLightClientAttackEvidence(t, "mychain")
+	bz, err := cdc.MarshalBinaryLengthPrefixed(ev)
+	require.NoError(t, err)
+
+	evl := EvidenceList([]Evidence{randomDuplicatedVoteEvidence(), ev})
+	assert.EqualValues(t, MaxEvidenceBytes+int64(len(bz)), evl.ByteSize())
+	assert.EqualValues(t, 0, EvidenceList(nil).ByteSize())
+}
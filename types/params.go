@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -20,6 +22,8 @@ type ConsensusParams struct {
 	BlockSize BlockSizeParams `json:"block_size"`
 	Evidence  EvidenceParams  `json:"evidence"`
 	Validator ValidatorParams `json:"validator"`
+	Timestamp TimestampParams `json:"timestamp"`
+	Timeout   TimeoutParams   `json:"timeout"`
 }
 
 // HashedParams is a subset of ConsensusParams.
@@ -47,12 +51,37 @@ type ValidatorParams struct {
 	PubKeyTypes []string `json:"pub_key_types"`
 }
 
+// TimestampParams configure how block times are set.
+// By default, the block time is the weighted median of the vote times of the
+// last commit (BFT time). With ProposerBased, the proposer sets the block time
+// from its clock, and validators only prevote for the block if they received
+// the proposal within [time-Precision, time+MessageDelay+Precision] of their own clock.
+type TimestampParams struct {
+	ProposerBased bool          `json:"proposer_based"`
+	Precision     time.Duration `json:"precision"`     // bound on the clock drift between validators
+	MessageDelay  time.Duration `json:"message_delay"` // bound on the delay of the proposal
+}
+
+// TimeoutParams are the consensus timeouts shared by all validators.
+// Zero timeouts are unset: each node uses the one in its ConsensusConfig.
+type TimeoutParams struct {
+	Propose        time.Duration `json:"propose"`
+	ProposeDelta   time.Duration `json:"propose_delta"`
+	Prevote        time.Duration `json:"prevote"`
+	PrevoteDelta   time.Duration `json:"prevote_delta"`
+	Precommit      time.Duration `json:"precommit"`
+	PrecommitDelta time.Duration `json:"precommit_delta"`
+	Commit         time.Duration `json:"commit"`
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
 		DefaultBlockSizeParams(),
 		DefaultEvidenceParams(),
 		DefaultValidatorParams(),
+		DefaultTimestampParams(),
+		DefaultTimeoutParams(),
 	}
 }
 
@@ -77,6 +106,29 @@ func DefaultValidatorParams() ValidatorParams {
 	return ValidatorParams{[]string{ABCIPubKeyTypeEd25519}}
 }
 
+// DefaultTimestampParams returns a default TimestampParams, which uses BFT time.
+func DefaultTimestampParams() TimestampParams {
+	return TimestampParams{
+		ProposerBased: false,
+		Precision:     500 * time.Millisecond,
+		MessageDelay:  3 * time.Second,
+	}
+}
+
+// DefaultTimeoutParams returns a default TimeoutParams, which leaves every
+// timeout unset.
+func DefaultTimeoutParams() TimeoutParams {
+	return TimeoutParams{}
+}
+
+// IsTimely returns true if a proposal with the given time, received at
+// receiveTime, was received within the window allowed by the params.
+func (params TimestampParams) IsTimely(proposalTime, receiveTime time.Time) bool {
+	lower := proposalTime.Add(-params.Precision)
+	upper := proposalTime.Add(params.MessageDelay + params.Precision)
+	return !receiveTime.Before(lower) && !receiveTime.After(upper)
+}
+
 func (params *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -112,6 +164,21 @@ func (params *ConsensusParams) Validate() error {
 		return cmn.NewError("len(Validator.PubKeyTypes) must be greater than 0")
 	}
 
+	if params.Timestamp.Precision < 0 || params.Timestamp.MessageDelay < 0 {
+		return cmn.NewError("Timestamp.Precision and Timestamp.MessageDelay must not be negative. Got %v, %v",
+			params.Timestamp.Precision, params.Timestamp.MessageDelay)
+	}
+	if params.Timestamp.ProposerBased && (params.Timestamp.Precision == 0 || params.Timestamp.MessageDelay == 0) {
+		return cmn.NewError("Timestamp.Precision and Timestamp.MessageDelay must be greater than 0 with Timestamp.ProposerBased")
+	}
+
+	if params.Timeout.Propose < 0 || params.Timeout.ProposeDelta < 0 ||
+		params.Timeout.Prevote < 0 || params.Timeout.PrevoteDelta < 0 ||
+		params.Timeout.Precommit < 0 || params.Timeout.PrecommitDelta < 0 ||
+		params.Timeout.Commit < 0 {
+		return cmn.NewError("Timeout params must not be negative. Got %+v", params.Timeout)
+	}
+
 	// Check if keyType is a known ABCIPubKeyType
 	for i := 0; i < len(params.Validator.PubKeyTypes); i++ {
 		keyType := params.Validator.PubKeyTypes[i]
@@ -144,7 +211,9 @@ func (params *ConsensusParams) Hash() []byte {
 func (params *ConsensusParams) Equals(params2 *ConsensusParams) bool {
 	return params.BlockSize == params2.BlockSize &&
 		params.Evidence == params2.Evidence &&
-		cmn.StringSliceEqual(params.Validator.PubKeyTypes, params2.Validator.PubKeyTypes)
+		cmn.StringSliceEqual(params.Validator.PubKeyTypes, params2.Validator.PubKeyTypes) &&
+		params.Timestamp == params2.Timestamp &&
+		params.Timeout == params2.Timeout
 }
 
 // Update returns a copy of the params with updates from the non-zero fields of p2.
@@ -169,5 +238,21 @@ func (params ConsensusParams) Update(params2 *abci.ConsensusParams) ConsensusPar
 		// This avoids having to initialize the slice to 0 values, and then write to it again.
 		res.Validator.PubKeyTypes = append([]string{}, params2.Validator.PubKeyTypes...)
 	}
+	if params2.Timestamp != nil {
+		res.Timestamp.ProposerBased = params2.Timestamp.ProposerBased
+		res.Timestamp.Precision = time.Duration(params2.Timestamp.Precision)
+		res.Timestamp.MessageDelay = time.Duration(params2.Timestamp.MessageDelay)
+	}
+	if params2.Timeout != nil {
+		res.Timeout = TimeoutParams{
+			Propose:        time.Duration(params2.Timeout.Propose),
+			ProposeDelta:   time.Duration(params2.Timeout.ProposeDelta),
+			Prevote:        time.Duration(params2.Timeout.Prevote),
+			PrevoteDelta:   time.Duration(params2.Timeout.PrevoteDelta),
+			Precommit:      time.Duration(params2.Timeout.Precommit),
+			PrecommitDelta: time.Duration(params2.Timeout.PrecommitDelta),
+			Commit:         time.Duration(params2.Timeout.Commit),
+		}
+	}
 	return res
 }
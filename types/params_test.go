@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -121,3 +122,84 @@ func TestConsensusParamsUpdate(t *testing.T) {
 		assert.Equal(t, tc.updatedParams, tc.params.Update(tc.updates))
 	}
 }
+
+func TestTimestampParamsValidation(t *testing.T) {
+	testCases := []struct {
+		params TimestampParams
+		valid  bool
+	}{
+		0: {TimestampParams{false, 0, 0}, true},
+		1: {TimestampParams{false, -1, 0}, false},
+		2: {TimestampParams{false, 0, -1}, false},
+		3: {TimestampParams{true, time.Second, time.Second}, true},
+		4: {TimestampParams{true, 0, time.Second}, false},
+		5: {TimestampParams{true, time.Second, 0}, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 1, valEd25519)
+		params.Timestamp = tc.params
+		if tc.valid {
+			assert.NoErrorf(t, params.Validate(), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, params.Validate(), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestTimestampParamsIsTimely(t *testing.T) {
+	params := TimestampParams{true, time.Second, 2 * time.Second}
+	proposalTime := time.Now()
+
+	testCases := []struct {
+		receiveTime time.Time
+		timely      bool
+	}{
+		0: {proposalTime, true},
+		1: {proposalTime.Add(-time.Second), true},
+		2: {proposalTime.Add(-time.Second - 1), false},
+		3: {proposalTime.Add(3 * time.Second), true},
+		4: {proposalTime.Add(3*time.Second + 1), false},
+	}
+	for i, tc := range testCases {
+		assert.Equalf(t, tc.timely, params.IsTimely(proposalTime, tc.receiveTime), "unexpected timeliness (#%d)", i)
+	}
+}
+
+func TestTimestampParamsUpdate(t *testing.T) {
+	params := makeParams(1, 2, 3, valEd25519)
+	updated := params.Update(&abci.ConsensusParams{
+		Timestamp: &abci.TimestampParams{
+			ProposerBased: true,
+			Precision:     int64(time.Second),
+			MessageDelay:  int64(2 * time.Second),
+		},
+	})
+	assert.Equal(t, TimestampParams{true, time.Second, 2 * time.Second}, updated.Timestamp)
+	assert.Equal(t, TimestampParams{}, params.Timestamp)
+}
+
+func TestTimeoutParamsValidation(t *testing.T) {
+	params := makeParams(1, 0, 1, valEd25519)
+	params.Timeout = TimeoutParams{Propose: time.Second, Commit: time.Second}
+	assert.NoError(t, params.Validate())
+
+	params.Timeout.PrevoteDelta = -1
+	assert.Error(t, params.Validate())
+}
+
+func TestTimeoutParamsUpdate(t *testing.T) {
+	params := makeParams(1, 2, 3, valEd25519)
+	params.Timeout = TimeoutParams{Propose: time.Second, Commit: time.Second}
+
+	// unset params are left unchanged
+	updated := params.Update(&abci.ConsensusParams{})
+	assert.Equal(t, params.Timeout, updated.Timeout)
+
+	updated = params.Update(&abci.ConsensusParams{
+		Timeout: &abci.TimeoutParams{
+			Propose:   int64(2 * time.Second),
+			Precommit: int64(time.Second),
+		},
+	})
+	assert.Equal(t, TimeoutParams{Propose: 2 * time.Second, Precommit: time.Second}, updated.Timeout)
+}
@@ -50,6 +50,12 @@ func NewMockPV() *MockPV {
 	return &MockPV{ed25519.GenPrivKey()}
 }
 
+// NewMockPVWithPrivKey returns a MockPV signing with the given key,
+// eg. one derived from a seed.
+func NewMockPVWithPrivKey(privKey crypto.PrivKey) *MockPV {
+	return &MockPV{privKey}
+}
+
 // Implements PrivValidator.
 func (pv *MockPV) GetPubKey() crypto.PubKey {
 	return pv.privKey.PubKey()
@@ -63,6 +69,13 @@ func (pv *MockPV) SignVote(chainID string, vote *Vote) error {
 		return err
 	}
 	vote.Signature = sig
+	if vote.IsExtendable() {
+		extSig, err := pv.privKey.Sign(vote.ExtensionSignBytes(chainID))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -17,6 +17,10 @@ import (
 const (
 	ABCIEvidenceTypeDuplicateVote = "duplicate/vote"
 	ABCIEvidenceTypeMockGood      = "mock/good"
+
+	// light client attacks, see LightClientAttackEvidence
+	ABCIEvidenceTypeLightClientEquivocation = "light_client_attack/equivocation"
+	ABCIEvidenceTypeLightClientLunatic      = "light_client_attack/lunatic"
 )
 
 const (
@@ -135,6 +139,20 @@ func (tm2pb) ConsensusParams(params *ConsensusParams) *abci.ConsensusParams {
 		Validator: &abci.ValidatorParams{
 			PubKeyTypes: params.Validator.PubKeyTypes,
 		},
+		Timestamp: &abci.TimestampParams{
+			ProposerBased: params.Timestamp.ProposerBased,
+			Precision:     int64(params.Timestamp.Precision),
+			MessageDelay:  int64(params.Timestamp.MessageDelay),
+		},
+		Timeout: &abci.TimeoutParams{
+			Propose:        int64(params.Timeout.Propose),
+			ProposeDelta:   int64(params.Timeout.ProposeDelta),
+			Prevote:        int64(params.Timeout.Prevote),
+			PrevoteDelta:   int64(params.Timeout.PrevoteDelta),
+			Precommit:      int64(params.Timeout.Precommit),
+			PrecommitDelta: int64(params.Timeout.PrecommitDelta),
+			Commit:         int64(params.Timeout.Commit),
+		},
 	}
 }
 
@@ -150,9 +168,11 @@ func (tm2pb) Evidence(ev Evidence, valSet *ValidatorSet, evTime time.Time) abci.
 
 	// set type
 	var evType string
-	switch ev.(type) {
+	switch ev := ev.(type) {
 	case *DuplicateVoteEvidence:
 		evType = ABCIEvidenceTypeDuplicateVote
+	case *LightClientAttackEvidence:
+		evType = ev.AttackType(valSet)
 	case MockGoodEvidence:
 		// XXX: not great to have test types in production paths ...
 		evType = ABCIEvidenceTypeMockGood
@@ -233,5 +253,21 @@ func (pb2tm) ConsensusParams(csp *abci.ConsensusParams) ConsensusParams {
 		Validator: ValidatorParams{
 			PubKeyTypes: csp.Validator.PubKeyTypes,
 		},
+		// Timestamp and Timeout may be unset by apps predating them,
+		// which leaves BFT time on and the timeouts to the node's config
+		Timestamp: TimestampParams{
+			ProposerBased: csp.GetTimestamp().GetProposerBased(),
+			Precision:     time.Duration(csp.GetTimestamp().GetPrecision()),
+			MessageDelay:  time.Duration(csp.GetTimestamp().GetMessageDelay()),
+		},
+		Timeout: TimeoutParams{
+			Propose:        time.Duration(csp.GetTimeout().GetPropose()),
+			ProposeDelta:   time.Duration(csp.GetTimeout().GetProposeDelta()),
+			Prevote:        time.Duration(csp.GetTimeout().GetPrevote()),
+			PrevoteDelta:   time.Duration(csp.GetTimeout().GetPrevoteDelta()),
+			Precommit:      time.Duration(csp.GetTimeout().GetPrecommit()),
+			PrecommitDelta: time.Duration(csp.GetTimeout().GetPrecommitDelta()),
+			Commit:         time.Duration(csp.GetTimeout().GetCommit()),
+		},
 	}
 }
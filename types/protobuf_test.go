@@ -155,6 +155,12 @@ func TestABCIEvidence(t *testing.T) {
 	)
 
 	assert.Equal(t, "duplicate/vote", abciEv.Type)
+
+	lcae, _ := makeLightClientAttackEvidence(t, chainID)
+	abciEv = TM2PB.Evidence(lcae, lcae.ConflictingValidators, time.Now())
+	assert.Equal(t, "light_client_attack/equivocation", abciEv.Type)
+	abciEv = TM2PB.Evidence(lcae, NewValidatorSet([]*Validator{NewValidator(lcae.PubKey, 10)}), time.Now())
+	assert.Equal(t, "light_client_attack/lunatic", abciEv.Type)
 }
 
 type pubKeyEddie struct{}
@@ -4,6 +4,8 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
+// MakeCommit returns the commit of the validators for blockID, without vote
+// extensions, as included in blocks.
 func MakeCommit(blockID BlockID, height int64, round int,
 	voteSet *VoteSet,
 	validators []PrivValidator) (*Commit, error) {
@@ -27,7 +29,7 @@ func MakeCommit(blockID BlockID, height int64, round int,
 		}
 	}
 
-	return voteSet.MakeCommit(), nil
+	return voteSet.MakeCommit().StripExtensions(), nil
 }
 
 func signAddVote(privVal PrivValidator, vote *Vote, voteSet *VoteSet) (signed bool, err error) {
@@ -12,7 +12,11 @@ import (
 
 const (
 	// MaxVoteBytes is a maximum vote size (including amino overhead).
+	// Vote extensions are not included, as they are stripped from commits.
 	MaxVoteBytes int64 = 223
+
+	// MaxVoteExtensionBytes is the maximum size of a vote extension.
+	MaxVoteExtensionBytes = 1024
 )
 
 var (
@@ -20,6 +24,8 @@ var (
 	ErrVoteInvalidValidatorIndex     = errors.New("Invalid validator index")
 	ErrVoteInvalidValidatorAddress   = errors.New("Invalid validator address")
 	ErrVoteInvalidSignature          = errors.New("Invalid signature")
+	ErrVoteInvalidExtensionSignature = errors.New("Invalid extension signature")
+	ErrVoteMissingExtensionSignature = errors.New("Missing extension signature")
 	ErrVoteInvalidBlockHash          = errors.New("Invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("Non-deterministic signature")
 	ErrVoteNil                       = errors.New("Nil vote")
@@ -48,15 +54,20 @@ type Address = crypto.Address
 
 // Vote represents a prevote, precommit, or commit vote from validators for
 // consensus.
+// Precommits for a block may carry an application-provided Extension. It is
+// signed separately from the vote, so that it can be stripped from the commit
+// included in the next block.
 type Vote struct {
-	Type             SignedMsgType `json:"type"`
-	Height           int64         `json:"height"`
-	Round            int           `json:"round"`
-	BlockID          BlockID       `json:"block_id"` // zero if vote is nil.
-	Timestamp        time.Time     `json:"timestamp"`
-	ValidatorAddress Address       `json:"validator_address"`
-	ValidatorIndex   int           `json:"validator_index"`
-	Signature        []byte        `json:"signature"`
+	Type               SignedMsgType `json:"type"`
+	Height             int64         `json:"height"`
+	Round              int           `json:"round"`
+	BlockID            BlockID       `json:"block_id"` // zero if vote is nil.
+	Timestamp          time.Time     `json:"timestamp"`
+	ValidatorAddress   Address       `json:"validator_address"`
+	ValidatorIndex     int           `json:"validator_index"`
+	Signature          []byte        `json:"signature"`
+	Extension          []byte        `json:"extension"`
+	ExtensionSignature []byte        `json:"extension_signature"`
 }
 
 func (vote *Vote) SignBytes(chainID string) []byte {
@@ -67,11 +78,34 @@ func (vote *Vote) SignBytes(chainID string) []byte {
 	return bz
 }
 
+// ExtensionSignBytes returns the bytes signed by ExtensionSignature.
+func (vote *Vote) ExtensionSignBytes(chainID string) []byte {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(CanonicalizeVoteExtension(chainID, vote))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
 }
 
+// IsExtendable returns true for precommits for a block, whose extension, even
+// if empty, is signed.
+func (vote *Vote) IsExtendable() bool {
+	return vote.Type == PrecommitType && !vote.BlockID.IsZero()
+}
+
+// StripExtension returns a copy of the vote without its extension.
+func (vote *Vote) StripExtension() *Vote {
+	voteCopy := *vote
+	voteCopy.Extension = nil
+	voteCopy.ExtensionSignature = nil
+	return &voteCopy
+}
+
 func (vote *Vote) String() string {
 	if vote == nil {
 		return "nil-Vote"
@@ -107,6 +141,29 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	if !pubKey.VerifyBytes(vote.SignBytes(chainID), vote.Signature) {
 		return ErrVoteInvalidSignature
 	}
+
+	// Commits stored without extensions are verified too.
+	if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+		if !pubKey.VerifyBytes(vote.ExtensionSignBytes(chainID), vote.ExtensionSignature) {
+			return ErrVoteInvalidExtensionSignature
+		}
+	}
+	return nil
+}
+
+// VerifyExtension checks that an extendable vote, as received from a peer,
+// carries a valid extension signature, even if the extension is empty, so
+// that relaying peers can't strip the extension.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	if !vote.IsExtendable() {
+		return nil
+	}
+	if len(vote.ExtensionSignature) == 0 {
+		return ErrVoteMissingExtensionSignature
+	}
+	if !pubKey.VerifyBytes(vote.ExtensionSignBytes(chainID), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
 	return nil
 }
 
@@ -147,5 +204,17 @@ func (vote *Vote) ValidateBasic() error {
 	if len(vote.Signature) > MaxSignatureSize {
 		return fmt.Errorf("Signature is too big (max: %d)", MaxSignatureSize)
 	}
+	if len(vote.Extension) > 0 && !vote.IsExtendable() {
+		return errors.New("Extension is only allowed in precommits for a block")
+	}
+	if len(vote.ExtensionSignature) > 0 && !vote.IsExtendable() {
+		return errors.New("ExtensionSignature is only allowed in precommits for a block")
+	}
+	if len(vote.Extension) > MaxVoteExtensionBytes {
+		return fmt.Errorf("Extension is too big (max: %d)", MaxVoteExtensionBytes)
+	}
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("ExtensionSignature is too big (max: %d)", MaxSignatureSize)
+	}
 	return nil
 }
@@ -529,6 +529,16 @@ func (voteSet *VoteSet) sumTotalFrac() (int64, int64, float64) {
 // Commit
 
 func (voteSet *VoteSet) MakeCommit() *Commit {
+	return voteSet.makeCommit(false)
+}
+
+// MakeExtendedCommit is like MakeCommit, but keeps the vote extensions
+// of the precommits. The result must not be included in a block.
+func (voteSet *VoteSet) MakeExtendedCommit() *Commit {
+	return voteSet.makeCommit(true)
+}
+
+func (voteSet *VoteSet) makeCommit(withExtensions bool) *Commit {
 	if voteSet.type_ != PrecommitType {
 		cmn.PanicSanity("Cannot MakeCommit() unless VoteSet.Type is PrecommitType")
 	}
@@ -542,7 +552,12 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 
 	// For every validator, get the precommit
 	votesCopy := make([]*Vote, len(voteSet.votes))
-	copy(votesCopy, voteSet.votes)
+	for i, vote := range voteSet.votes {
+		if vote != nil && !withExtensions && (len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0) {
+			vote = vote.StripExtension()
+		}
+		votesCopy[i] = vote
+	}
 	return &Commit{
 		BlockID:    *voteSet.maj23,
 		Precommits: votesCopy,
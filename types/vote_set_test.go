@@ -528,3 +528,57 @@ func TestMakeCommit(t *testing.T) {
 	}
 
 }
+
+func TestMakeExtendedCommit(t *testing.T) {
+	height, round := int64(1), 0
+	voteSet, _, privValidators := randVoteSet(height, round, PrecommitType, 4, 1)
+	blockHash, blockPartsHeader := crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}
+
+	voteProto := &Vote{
+		ValidatorAddress: nil,
+		ValidatorIndex:   -1,
+		Height:           height,
+		Round:            round,
+		Timestamp:        tmtime.Now(),
+		Type:             PrecommitType,
+		BlockID:          BlockID{blockHash, blockPartsHeader},
+		Extension:        []byte("extension"),
+	}
+
+	for i := 0; i < 4; i++ {
+		addr := privValidators[i].GetPubKey().Address()
+		vote := withValidator(voteProto, addr, i)
+		if i == 3 {
+			// empty extensions are signed too
+			vote.Extension = nil
+		}
+		_, err := signAddVote(privValidators[i], vote, voteSet)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	// The extensions are kept only in the extended commit.
+	extCommit := voteSet.MakeExtendedCommit()
+	commit := voteSet.MakeCommit()
+	for i := 0; i < 4; i++ {
+		extension := []byte("extension")
+		if i == 3 {
+			extension = nil
+		}
+		if !bytes.Equal(extCommit.Precommits[i].Extension, extension) ||
+			len(extCommit.Precommits[i].ExtensionSignature) == 0 {
+			t.Errorf("Extended commit precommit %d should keep its extension", i)
+		}
+		if commit.Precommits[i].Extension != nil || commit.Precommits[i].ExtensionSignature != nil {
+			t.Errorf("Commit precommit %d should have no extension", i)
+		}
+	}
+	if !bytes.Equal(commit.Hash(), extCommit.StripExtensions().Hash()) {
+		t.Errorf("Stripped extended commit should hash like the commit")
+	}
+
+	if err := commit.ValidateBasic(); err != nil {
+		t.Errorf("Error in Commit.ValidateBasic(): %v", err)
+	}
+}
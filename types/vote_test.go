@@ -206,6 +206,52 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVoteVerifyExtension(t *testing.T) {
+	privVal := NewMockPV()
+	pubkey := privVal.GetPubKey()
+
+	vote := examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	err := privVal.SignVote("test_chain_id", vote)
+	require.NoError(t, err)
+	require.NotEmpty(t, vote.ExtensionSignature)
+
+	// the extension is signed separately from the vote
+	assert.NoError(t, vote.Verify("test_chain_id", pubkey))
+	assert.True(t, pubkey.VerifyBytes(vote.StripExtension().SignBytes("test_chain_id"), vote.Signature))
+
+	vote.Extension = []byte("other extension")
+	err = vote.Verify("test_chain_id", pubkey)
+	if assert.Error(t, err) {
+		assert.Equal(t, ErrVoteInvalidExtensionSignature, err)
+	}
+
+	stripped := vote.StripExtension()
+	assert.Nil(t, stripped.Extension)
+	assert.Nil(t, stripped.ExtensionSignature)
+	assert.NoError(t, stripped.Verify("test_chain_id", pubkey))
+	// but a precommit for a block received from a peer must carry the
+	// extension signature
+	assert.Equal(t, ErrVoteMissingExtensionSignature, stripped.VerifyExtension("test_chain_id", pubkey))
+
+	// empty extensions are signed too
+	vote = examplePrecommit()
+	vote.ValidatorAddress = pubkey.Address()
+	require.NoError(t, privVal.SignVote("test_chain_id", vote))
+	require.NotEmpty(t, vote.ExtensionSignature)
+	assert.NoError(t, vote.VerifyExtension("test_chain_id", pubkey))
+	vote.Extension = []byte("injected extension")
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+
+	// which only precommits for a block have
+	prevote := examplePrevote()
+	prevote.ValidatorAddress = pubkey.Address()
+	require.NoError(t, privVal.SignVote("test_chain_id", prevote))
+	assert.Empty(t, prevote.ExtensionSignature)
+	assert.NoError(t, prevote.VerifyExtension("test_chain_id", pubkey))
+}
+
 func TestMaxVoteBytes(t *testing.T) {
 	// time is varint encoded so need to pick the max.
 	// year int, month Month, day, hour, min, sec, nsec int, loc *Location
@@ -267,6 +313,13 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Good Extension", func(v *Vote) { v.Extension = []byte("extension") }, false},
+		{"Extension in Prevote", func(v *Vote) { v.Type = PrevoteType; v.Extension = []byte("extension") }, true},
+		{"Extension in nil Precommit", func(v *Vote) { v.BlockID = BlockID{}; v.Extension = []byte("extension") }, true},
+		{"Too big Extension", func(v *Vote) { v.Extension = make([]byte, MaxVoteExtensionBytes+1) }, true},
+		{"Too big ExtensionSignature", func(v *Vote) { v.ExtensionSignature = make([]byte, MaxSignatureSize+1) }, true},
+		{"ExtensionSignature in Prevote", func(v *Vote) { v.Type = PrevoteType }, true},
+		{"ExtensionSignature in nil Precommit", func(v *Vote) { v.BlockID = BlockID{} }, true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
@@ -21,7 +21,7 @@ const (
 	TMCoreSemVer = "0.29.1"
 
 	// ABCISemVer is the semantic version of the ABCI library
-	ABCISemVer  = "0.15.0"
+	ABCISemVer  = "0.16.0"
 	ABCIVersion = ABCISemVer
 )
 
@@ -39,7 +39,7 @@ var (
 	P2PProtocol Protocol = 6
 
 	// BlockProtocol versions all block data structures and processing.
-	BlockProtocol Protocol = 9
+	BlockProtocol Protocol = 10
 )
 
 //------------------------------------------------------------------------